@@ -15,6 +15,10 @@ const (
 	authDataVersionV2  = "2.0"
 	authDataVersionV21 = "2.1"
 	defaultAuthHost    = "auth.scalingo.com"
+
+	// ContextFileName is the per-directory context file looked up from the
+	// working directory upwards to infer the app and region.
+	ContextFileName = ".scalingo.json"
 )
 
 // AuthConfig holds the authentication configuration loaded from file
@@ -47,9 +51,28 @@ type ConfigPerHost map[string]CredentialsData
 
 // Config holds the CLI configuration
 type Config struct {
-	v        *viper.Viper
-	AuthFile string
-	Region   string
+	v          *viper.Viper
+	AuthFile   string
+	ConfigFile string
+	ThemesFile string
+	Region     string
+	Theme      string
+
+	// Values set by the persistent root flags. They take precedence over
+	// environment variables and configuration files.
+	AppFlag          string
+	RegionFlag       string
+	OutputFlag       string
+	ColumnsFlag      []string
+	WideFlag         bool
+	DepthFlag        int
+	QueryFlag        string
+	TemplateFileFlag string
+	APITokenFlag     string
+
+	// Values read from the per-directory context file
+	contextApp    string
+	contextRegion string
 }
 
 // C is the global config instance
@@ -76,28 +99,61 @@ func (c *Config) init() {
 
 	configDir := filepath.Join(home, ".config", "scalingo")
 	c.AuthFile = filepath.Join(configDir, "auth")
+	c.ConfigFile = filepath.Join(configDir, "config.json")
+	c.ThemesFile = filepath.Join(configDir, "themes.toml")
+	c.OutputFlag = "table"
 
 	// Check for env override
 	if envAuth := os.Getenv("SCALINGO_AUTH_FILE"); envAuth != "" {
 		c.AuthFile = envAuth
 	}
+	if envThemes := os.Getenv("SCALINGO_THEMES_FILE"); envThemes != "" {
+		c.ThemesFile = envThemes
+	}
 
-	// Region from env
+	c.Load()
+}
+
+// Load resolves the region and the theme and reads the per-directory context
+// file. It is called again once the root flags are parsed, as --config may
+// point to a different configuration file.
+func (c *Config) Load() {
+	c.v.SetConfigFile(c.ConfigFile)
+	c.v.SetConfigType("json")
+	readConfig := c.v.ReadInConfig() == nil
+
+	// Region from env, then from the config file
 	c.Region = os.Getenv("SCALINGO_REGION")
-	if c.Region == "" {
-		// Try to read from config file
-		configPath := filepath.Join(configDir, "config.json")
-		c.v.SetConfigFile(configPath)
-		c.v.SetConfigType("json")
-		if err := c.v.ReadInConfig(); err == nil {
-			c.Region = c.v.GetString("region")
+	if c.Region == "" && readConfig {
+		c.Region = c.v.GetString("region")
+	}
+
+	// Theme from env, then from the config file
+	c.Theme = os.Getenv("SCALINGO_THEME")
+	if c.Theme == "" && readConfig {
+		c.Theme = c.v.GetString("theme")
+	}
+
+	c.contextApp, c.contextRegion = "", ""
+	if path := findContextFile(); path != "" {
+		v := viper.New()
+		v.SetConfigFile(path)
+		v.SetConfigType("json")
+		if err := v.ReadInConfig(); err == nil {
+			c.contextApp = v.GetString("app")
+			c.contextRegion = v.GetString("region")
 		}
 	}
 }
 
 // LoadAuth loads authentication from the auth file
 func (c *Config) LoadAuth() (string, error) {
-	// First check for env var override
+	// The --api-token flag wins over everything else
+	if c.APITokenFlag != "" {
+		return c.APITokenFlag, nil
+	}
+
+	// Then check for env var override
 	if token := os.Getenv("SCALINGO_API_TOKEN"); token != "" {
 		return token, nil
 	}
@@ -167,9 +223,60 @@ func (c *Config) LoadAuth() (string, error) {
 
 // GetRegion returns the configured region
 func (c *Config) GetRegion() string {
+	if c.RegionFlag != "" {
+		return c.RegionFlag
+	}
+	if os.Getenv("SCALINGO_REGION") == "" && c.contextRegion != "" {
+		return c.contextRegion
+	}
 	return c.Region
 }
 
+// GetApp returns the app to operate on: the --app flag, then the SCALINGO_APP
+// env var, then the per-directory context file.
+func (c *Config) GetApp() string {
+	if c.AppFlag != "" {
+		return c.AppFlag
+	}
+	if app := os.Getenv("SCALINGO_APP"); app != "" {
+		return app
+	}
+	return c.contextApp
+}
+
+// RequireApp returns the app to operate on, or an error if none could be inferred
+func (c *Config) RequireApp() (string, error) {
+	app := c.GetApp()
+	if app == "" {
+		return "", fmt.Errorf("no app specified: use --app, set SCALINGO_APP or add a %s file", ContextFileName)
+	}
+	return app, nil
+}
+
+// GetOutput returns the output format selected with --output
+func (c *Config) GetOutput() string {
+	return c.OutputFlag
+}
+
+// findContextFile looks for the context file in the working directory and its parents
+func findContextFile() string {
+	dir, err := os.Getwd()
+	if err != nil {
+		return ""
+	}
+	for {
+		path := filepath.Join(dir, ContextFileName)
+		if _, err := os.Stat(path); err == nil {
+			return path
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
+
 func homeDir() string {
 	if runtime.GOOS == "windows" {
 		home := os.Getenv("HOMEDRIVE") + os.Getenv("HOMEPATH")
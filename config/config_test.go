@@ -0,0 +1,123 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// useContextDir runs the test in a subdirectory of a directory holding a
+// context file naming ctx-app in ctx-region
+func useContextDir(t *testing.T) string {
+	t.Helper()
+	root := t.TempDir()
+	path := filepath.Join(root, ContextFileName)
+	if err := os.WriteFile(path, []byte(`{"app": "ctx-app", "region": "ctx-region"}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	dir := filepath.Join(root, "sub", "dir")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	t.Chdir(dir)
+	return path
+}
+
+// newTestConfig loads a config whose configuration file sets file-region
+func newTestConfig(t *testing.T) *Config {
+	t.Helper()
+	t.Setenv("HOME", t.TempDir())
+	c := New()
+	c.ConfigFile = filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(c.ConfigFile, []byte(`{"region": "file-region"}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	c.Load()
+	return c
+}
+
+func TestGetApp(t *testing.T) {
+	tests := []struct {
+		name    string
+		flag    string
+		env     string
+		context bool
+		want    string
+	}{
+		{name: "flag", flag: "flag-app", env: "env-app", context: true, want: "flag-app"},
+		{name: "env", env: "env-app", context: true, want: "env-app"},
+		{name: "context file", context: true, want: "ctx-app"},
+		{name: "none"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("SCALINGO_APP", tt.env)
+			if tt.context {
+				useContextDir(t)
+			} else {
+				t.Chdir(t.TempDir())
+			}
+			c := newTestConfig(t)
+			c.AppFlag = tt.flag
+
+			if got := c.GetApp(); got != tt.want {
+				t.Errorf("GetApp() = %q, want %q", got, tt.want)
+			}
+			if _, err := c.RequireApp(); (err != nil) != (tt.want == "") {
+				t.Errorf("RequireApp() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestGetRegion(t *testing.T) {
+	tests := []struct {
+		name    string
+		flag    string
+		env     string
+		context bool
+		want    string
+	}{
+		{name: "flag", flag: "flag-region", env: "env-region", context: true, want: "flag-region"},
+		{name: "env", env: "env-region", context: true, want: "env-region"},
+		{name: "context file", context: true, want: "ctx-region"},
+		{name: "configuration file", want: "file-region"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("SCALINGO_REGION", tt.env)
+			if tt.context {
+				useContextDir(t)
+			} else {
+				t.Chdir(t.TempDir())
+			}
+			c := newTestConfig(t)
+			c.RegionFlag = tt.flag
+
+			if got := c.GetRegion(); got != tt.want {
+				t.Errorf("GetRegion() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFindContextFile(t *testing.T) {
+	path := useContextDir(t)
+	got := findContextFile()
+
+	// The temporary directory may be reached through a symlink
+	want, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err = filepath.EvalSymlinks(got); err != nil || got != want {
+		t.Errorf("findContextFile() = %q, want %q", got, want)
+	}
+}
+
+func TestFindContextFileNone(t *testing.T) {
+	t.Chdir(t.TempDir())
+	if got := findContextFile(); got != "" {
+		t.Errorf("findContextFile() = %q, want none", got)
+	}
+}
@@ -2,13 +2,10 @@
 package commands
 
 import (
-	"context"
 	"fmt"
 
-	scalingo "github.com/Scalingo/go-scalingo/v8"
 	"github.com/spf13/cobra"
 
-	"generative-cli/config"
 	"generative-cli/render"
 )
 
@@ -16,37 +13,53 @@ var addonProvidersListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "AddonProviders list",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["addon-providers list"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		result, err := client.AddonProvidersList(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		if browsing(cmd) {
+			if err := browse(cmd, hooks, result); err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+			return nil
+		}
+
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -54,46 +67,63 @@ var addonProvidersListCmd = &cobra.Command{
 
 func initaddonProvidersListCmd() {
 
-	addonProvidersListCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
+	addListFlags(addonProvidersListCmd)
+
 }
 
 var addonProvidersAddonProviderPlansListCmd = &cobra.Command{
 	Use:   "addon-provider-plans-list",
 	Short: "AddonProviders addon-provider-plans-list",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["addon-providers addon-provider-plans-list"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		client, err := newClient(ctx)
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		addon, _ := cmd.Flags().GetString("addon")
 
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
 		result, err := client.AddonProviderPlansList(ctx, addon)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		if browsing(cmd) {
+			if err := browse(cmd, hooks, result); err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+			return nil
+		}
+
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -103,14 +133,16 @@ func initaddonProvidersAddonProviderPlansListCmd() {
 
 	addonProvidersAddonProviderPlansListCmd.Flags().String("addon", "", "addon parameter")
 
-	addonProvidersAddonProviderPlansListCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
+	addListFlags(addonProvidersAddonProviderPlansListCmd)
+
 }
 
 // RegisterAddonProvidersServiceCommands registers all generated commands with the parent
 func RegisterAddonProvidersServiceCommands(parent *cobra.Command) {
 	serviceCmd := &cobra.Command{
-		Use:   "addon_providers",
-		Short: "AddonProvidersService operations",
+		Use:     "addon-providers",
+		Aliases: []string{"addon_providers"},
+		Short:   "AddonProvidersService operations",
 	}
 
 	initaddonProvidersListCmd()
@@ -2,7 +2,6 @@
 package commands
 
 import (
-	"context"
 	"fmt"
 
 	scalingo "github.com/Scalingo/go-scalingo/v8"
@@ -16,39 +15,59 @@ var addonsListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "Addons list",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["addons list"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		app, _ := cmd.Flags().GetString("app")
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		result, err := client.AddonsList(ctx, app)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		if browsing(cmd) {
+			if err := browse(cmd, hooks, result); err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+			return nil
+		}
+
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -56,39 +75,50 @@ var addonsListCmd = &cobra.Command{
 
 func initaddonsListCmd() {
 
-	addonsListCmd.Flags().StringP("app", "a", "", "app parameter")
+	addListFlags(addonsListCmd)
 
-	addonsListCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var addonsAddonProvisionCmd = &cobra.Command{
 	Use:   "addon-provision",
 	Short: "Addons addon-provision",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["addons addon-provision"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		app, _ := cmd.Flags().GetString("app")
+		addonProviderIDFlag, _ := cmd.Flags().GetString("addon-provider-id")
 
-		addonProviderIDFlag, _ := cmd.Flags().GetString("addon-provider---a-c-r0--")
+		planIDFlag, _ := cmd.Flags().GetString("plan-id")
 
-		planIDFlag, _ := cmd.Flags().GetString("plan---a-c-r0--")
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		params := scalingo.AddonProvisionParams{
 			AddonProviderID: addonProviderIDFlag,
@@ -97,16 +127,16 @@ var addonsAddonProvisionCmd = &cobra.Command{
 
 		result, err := client.AddonProvision(ctx, app, params)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -114,45 +144,78 @@ var addonsAddonProvisionCmd = &cobra.Command{
 
 func initaddonsAddonProvisionCmd() {
 
-	addonsAddonProvisionCmd.Flags().StringP("app", "a", "", "app parameter")
-
-	addonsAddonProvisionCmd.Flags().String("addon-provider---a-c-r0--", "", "AddonProviderID field")
+	addonsAddonProvisionCmd.Flags().String("addon-provider-id", "", "AddonProviderID field")
 
-	addonsAddonProvisionCmd.Flags().String("plan---a-c-r0--", "", "PlanID field")
+	addonsAddonProvisionCmd.Flags().String("plan-id", "", "PlanID field")
 
-	addonsAddonProvisionCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var addonsAddonDestroyCmd = &cobra.Command{
 	Use:   "addon-destroy",
 	Short: "Addons addon-destroy",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["addons addon-destroy"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		app, err := config.C.RequireApp()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		app, _ := cmd.Flags().GetString("app")
+		addonID, _ := cmd.Flags().GetString("addon-id")
 
-		addonID, _ := cmd.Flags().GetString("addon-i-d")
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		// Resolve --addon-id or --addon from AddonsList, IDs being used as is
+		addonIDSelector, _ := cmd.Flags().GetString("addon")
+		if looksLikeID(addonIDSelector) {
+			addonID = addonIDSelector
+		} else if addonIDSelector != "" || addonID != "" && !looksLikeID(addonID) {
+			addonIDList, err := client.AddonsList(ctx, app)
+			if err == nil {
+				addonIDItems := make([]resolveItem, len(addonIDList))
+				for i, item := range addonIDList {
+					addonIDItems[i].ID = item.ID
+					addonIDItems[i].Names = []string{item.Name}
+					addonIDItems[i].CreatedAt = item.CreatedAt
+				}
+				if addonIDSelector != "" {
+					addonID, err = resolveID("addon", addonIDSelector, addonIDItems, true)
+				} else {
+					addonID, err = resolveName("addon", addonID, addonIDItems, true)
+				}
+			} else if addonIDSelector == "" {
+				// --addon-id goes to the API as given when it can't be listed
+				err = nil
+			}
+			if err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+		}
 
 		if err := client.AddonDestroy(ctx, app, addonID); err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(render.RenderSuccess("addon-destroy completed successfully"))
+		fmt.Fprintln(cmd.OutOrStdout(), render.RenderSuccess("addon-destroy completed successfully"))
 
 		return nil
 	},
@@ -160,41 +223,82 @@ var addonsAddonDestroyCmd = &cobra.Command{
 
 func initaddonsAddonDestroyCmd() {
 
-	addonsAddonDestroyCmd.Flags().StringP("app", "a", "", "app parameter")
+	addonsAddonDestroyCmd.Flags().String("addon-id", "", "Addon ID or name")
 
-	addonsAddonDestroyCmd.Flags().String("addon-i-d", "", "addonID parameter")
+	addonsAddonDestroyCmd.Flags().String("addon", "", "Addon to use instead of --addon-id: latest, or its ID or name")
+
+	addonsAddonDestroyCmd.MarkFlagsMutuallyExclusive("addon-id", "addon")
 
-	addonsAddonDestroyCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var addonsAddonUpgradeCmd = &cobra.Command{
 	Use:   "addon-upgrade",
 	Short: "Addons addon-upgrade",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
+
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["addons addon-upgrade"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		authToken, err := config.C.LoadAuth()
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		app, _ := cmd.Flags().GetString("app")
+		addonID, _ := cmd.Flags().GetString("addon-id")
 
-		addonID, _ := cmd.Flags().GetString("addon-i-d")
+		planIDFlag, _ := cmd.Flags().GetString("plan-id")
 
-		planIDFlag, _ := cmd.Flags().GetString("plan---a-c-r0--")
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		// Resolve --addon-id or --addon from AddonsList, IDs being used as is
+		addonIDSelector, _ := cmd.Flags().GetString("addon")
+		if looksLikeID(addonIDSelector) {
+			addonID = addonIDSelector
+		} else if addonIDSelector != "" || addonID != "" && !looksLikeID(addonID) {
+			addonIDList, err := client.AddonsList(ctx, app)
+			if err == nil {
+				addonIDItems := make([]resolveItem, len(addonIDList))
+				for i, item := range addonIDList {
+					addonIDItems[i].ID = item.ID
+					addonIDItems[i].Names = []string{item.Name}
+					addonIDItems[i].CreatedAt = item.CreatedAt
+				}
+				if addonIDSelector != "" {
+					addonID, err = resolveID("addon", addonIDSelector, addonIDItems, false)
+				} else {
+					addonID, err = resolveName("addon", addonID, addonIDItems, false)
+				}
+			} else if addonIDSelector == "" {
+				// --addon-id goes to the API as given when it can't be listed
+				err = nil
+			}
+			if err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+		}
 
 		params := scalingo.AddonUpgradeParams{
 			PlanID: planIDFlag,
@@ -202,16 +306,16 @@ var addonsAddonUpgradeCmd = &cobra.Command{
 
 		result, err := client.AddonUpgrade(ctx, app, addonID, params)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -219,54 +323,95 @@ var addonsAddonUpgradeCmd = &cobra.Command{
 
 func initaddonsAddonUpgradeCmd() {
 
-	addonsAddonUpgradeCmd.Flags().StringP("app", "a", "", "app parameter")
+	addonsAddonUpgradeCmd.Flags().String("addon-id", "", "Addon ID, name or unique prefix")
 
-	addonsAddonUpgradeCmd.Flags().String("addon-i-d", "", "addonID parameter")
+	addonsAddonUpgradeCmd.Flags().String("addon", "", "Addon to use instead of --addon-id: latest, or its ID, name or unique prefix")
 
-	addonsAddonUpgradeCmd.Flags().String("plan---a-c-r0--", "", "PlanID field")
+	addonsAddonUpgradeCmd.Flags().String("plan-id", "", "PlanID field")
+
+	addonsAddonUpgradeCmd.MarkFlagsMutuallyExclusive("addon-id", "addon")
 
-	addonsAddonUpgradeCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var addonsAddonTokenCmd = &cobra.Command{
 	Use:   "addon-token",
 	Short: "Addons addon-token",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["addons addon-token"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		app, _ := cmd.Flags().GetString("app")
+		addonID, _ := cmd.Flags().GetString("addon-id")
+
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		addonID, _ := cmd.Flags().GetString("addon-i-d")
+		// Resolve --addon-id or --addon from AddonsList, IDs being used as is
+		addonIDSelector, _ := cmd.Flags().GetString("addon")
+		if looksLikeID(addonIDSelector) {
+			addonID = addonIDSelector
+		} else if addonIDSelector != "" || addonID != "" && !looksLikeID(addonID) {
+			addonIDList, err := client.AddonsList(ctx, app)
+			if err == nil {
+				addonIDItems := make([]resolveItem, len(addonIDList))
+				for i, item := range addonIDList {
+					addonIDItems[i].ID = item.ID
+					addonIDItems[i].Names = []string{item.Name}
+					addonIDItems[i].CreatedAt = item.CreatedAt
+				}
+				if addonIDSelector != "" {
+					addonID, err = resolveID("addon", addonIDSelector, addonIDItems, false)
+				} else {
+					addonID, err = resolveName("addon", addonID, addonIDItems, false)
+				}
+			} else if addonIDSelector == "" {
+				// --addon-id goes to the API as given when it can't be listed
+				err = nil
+			}
+			if err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+		}
 
 		result, err := client.AddonToken(ctx, app, addonID)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -274,52 +419,94 @@ var addonsAddonTokenCmd = &cobra.Command{
 
 func initaddonsAddonTokenCmd() {
 
-	addonsAddonTokenCmd.Flags().StringP("app", "a", "", "app parameter")
+	addonsAddonTokenCmd.Flags().String("addon-id", "", "Addon ID, name or unique prefix")
+
+	addonsAddonTokenCmd.Flags().String("addon", "", "Addon to use instead of --addon-id: latest, or its ID, name or unique prefix")
 
-	addonsAddonTokenCmd.Flags().String("addon-i-d", "", "addonID parameter")
+	addonsAddonTokenCmd.MarkFlagsMutuallyExclusive("addon-id", "addon")
 
-	addonsAddonTokenCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var addonsAddonLogsURLCmd = &cobra.Command{
-	Use:   "addon-logs-u-r-l",
-	Short: "Addons addon-logs-u-r-l",
+	Use:     "addon-logs-url",
+	Aliases: []string{"addon-logs-u-r-l"},
+	Short:   "Addons addon-logs-url",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
+
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["addons addon-logs-url"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		outputFormat, err := selectedFormat()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		authToken, err := config.C.LoadAuth()
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		app, err := config.C.RequireApp()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		addonID, _ := cmd.Flags().GetString("addon-id")
 
-		app, _ := cmd.Flags().GetString("app")
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		addonID, _ := cmd.Flags().GetString("addon-i-d")
+		// Resolve --addon-id or --addon from AddonsList, IDs being used as is
+		addonIDSelector, _ := cmd.Flags().GetString("addon")
+		if looksLikeID(addonIDSelector) {
+			addonID = addonIDSelector
+		} else if addonIDSelector != "" || addonID != "" && !looksLikeID(addonID) {
+			addonIDList, err := client.AddonsList(ctx, app)
+			if err == nil {
+				addonIDItems := make([]resolveItem, len(addonIDList))
+				for i, item := range addonIDList {
+					addonIDItems[i].ID = item.ID
+					addonIDItems[i].Names = []string{item.Name}
+					addonIDItems[i].CreatedAt = item.CreatedAt
+				}
+				if addonIDSelector != "" {
+					addonID, err = resolveID("addon", addonIDSelector, addonIDItems, false)
+				} else {
+					addonID, err = resolveName("addon", addonID, addonIDItems, false)
+				}
+			} else if addonIDSelector == "" {
+				// --addon-id goes to the API as given when it can't be listed
+				err = nil
+			}
+			if err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+		}
 
 		result, err := client.AddonLogsURL(ctx, app, addonID)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -327,54 +514,95 @@ var addonsAddonLogsURLCmd = &cobra.Command{
 
 func initaddonsAddonLogsURLCmd() {
 
-	addonsAddonLogsURLCmd.Flags().StringP("app", "a", "", "app parameter")
+	addonsAddonLogsURLCmd.Flags().String("addon-id", "", "Addon ID, name or unique prefix")
+
+	addonsAddonLogsURLCmd.Flags().String("addon", "", "Addon to use instead of --addon-id: latest, or its ID, name or unique prefix")
 
-	addonsAddonLogsURLCmd.Flags().String("addon-i-d", "", "addonID parameter")
+	addonsAddonLogsURLCmd.MarkFlagsMutuallyExclusive("addon-id", "addon")
 
-	addonsAddonLogsURLCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var addonsAddonLogsArchivesCmd = &cobra.Command{
 	Use:   "addon-logs-archives",
 	Short: "Addons addon-logs-archives",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["addons addon-logs-archives"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		client, err := newClient(ctx)
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		app, _ := cmd.Flags().GetString("app")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		addonID, _ := cmd.Flags().GetString("addon-i-d")
+		addonID, _ := cmd.Flags().GetString("addon-id")
 
 		page, _ := cmd.Flags().GetInt("page")
 
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		// Resolve --addon-id or --addon from AddonsList, IDs being used as is
+		addonIDSelector, _ := cmd.Flags().GetString("addon")
+		if looksLikeID(addonIDSelector) {
+			addonID = addonIDSelector
+		} else if addonIDSelector != "" || addonID != "" && !looksLikeID(addonID) {
+			addonIDList, err := client.AddonsList(ctx, app)
+			if err == nil {
+				addonIDItems := make([]resolveItem, len(addonIDList))
+				for i, item := range addonIDList {
+					addonIDItems[i].ID = item.ID
+					addonIDItems[i].Names = []string{item.Name}
+					addonIDItems[i].CreatedAt = item.CreatedAt
+				}
+				if addonIDSelector != "" {
+					addonID, err = resolveID("addon", addonIDSelector, addonIDItems, false)
+				} else {
+					addonID, err = resolveName("addon", addonID, addonIDItems, false)
+				}
+			} else if addonIDSelector == "" {
+				// --addon-id goes to the API as given when it can't be listed
+				err = nil
+			}
+			if err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+		}
+
 		result, err := client.AddonLogsArchives(ctx, app, addonID, page)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -382,13 +610,14 @@ var addonsAddonLogsArchivesCmd = &cobra.Command{
 
 func initaddonsAddonLogsArchivesCmd() {
 
-	addonsAddonLogsArchivesCmd.Flags().StringP("app", "a", "", "app parameter")
+	addonsAddonLogsArchivesCmd.Flags().String("addon-id", "", "Addon ID, name or unique prefix")
 
-	addonsAddonLogsArchivesCmd.Flags().String("addon-i-d", "", "addonID parameter")
+	addonsAddonLogsArchivesCmd.Flags().String("addon", "", "Addon to use instead of --addon-id: latest, or its ID, name or unique prefix")
 
 	addonsAddonLogsArchivesCmd.Flags().Int("page", 0, "page parameter")
 
-	addonsAddonLogsArchivesCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
+	addonsAddonLogsArchivesCmd.MarkFlagsMutuallyExclusive("addon-id", "addon")
+
 }
 
 // RegisterAddonsServiceCommands registers all generated commands with the parent
@@ -2,7 +2,6 @@
 package commands
 
 import (
-	"context"
 	"fmt"
 
 	scalingo "github.com/Scalingo/go-scalingo/v8"
@@ -16,39 +15,59 @@ var alertsListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "Alerts list",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["alerts list"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		app, _ := cmd.Flags().GetString("app")
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		result, err := client.AlertsList(ctx, app)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		if browsing(cmd) {
+			if err := browse(cmd, hooks, result); err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+			return nil
+		}
+
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -56,35 +75,41 @@ var alertsListCmd = &cobra.Command{
 
 func initalertsListCmd() {
 
-	alertsListCmd.Flags().StringP("app", "a", "", "app parameter")
+	addListFlags(alertsListCmd)
 
-	alertsListCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var alertsAlertAddCmd = &cobra.Command{
 	Use:   "alert-add",
 	Short: "Alerts alert-add",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["alerts alert-add"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		client, err := newClient(ctx)
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		app, _ := cmd.Flags().GetString("app")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		containerTypeFlag, _ := cmd.Flags().GetString("container-type")
 
@@ -98,6 +123,11 @@ var alertsAlertAddCmd = &cobra.Command{
 
 		notifiersFlag, _ := cmd.Flags().GetStringSlice("notifiers")
 
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
 		params := scalingo.AlertAddParams{
 			ContainerType: containerTypeFlag,
 			Metric:        metricFlag,
@@ -109,16 +139,16 @@ var alertsAlertAddCmd = &cobra.Command{
 
 		result, err := client.AlertAdd(ctx, app, params)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -126,8 +156,6 @@ var alertsAlertAddCmd = &cobra.Command{
 
 func initalertsAlertAddCmd() {
 
-	alertsAlertAddCmd.Flags().StringP("app", "a", "", "app parameter")
-
 	alertsAlertAddCmd.Flags().String("container-type", "", "ContainerType field")
 
 	alertsAlertAddCmd.Flags().String("metric", "", "Metric field")
@@ -140,48 +168,87 @@ func initalertsAlertAddCmd() {
 
 	alertsAlertAddCmd.Flags().StringSlice("notifiers", nil, "Notifiers field")
 
-	alertsAlertAddCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var alertsAlertShowCmd = &cobra.Command{
 	Use:   "alert-show",
 	Short: "Alerts alert-show",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["alerts alert-show"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		client, err := newClient(ctx)
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		app, _ := cmd.Flags().GetString("app")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		id, _ := cmd.Flags().GetString("id")
 
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		// Resolve --id or --alert from AlertsList, IDs being used as is
+		idSelector, _ := cmd.Flags().GetString("alert")
+		if looksLikeID(idSelector) {
+			id = idSelector
+		} else if idSelector != "" || id != "" && !looksLikeID(id) {
+			idList, err := client.AlertsList(ctx, app)
+			if err == nil {
+				idItems := make([]resolveItem, len(idList))
+				for i, item := range idList {
+					idItems[i].ID = item.ID
+					idItems[i].Names = []string{item.Name}
+					idItems[i].CreatedAt = item.CreatedAt
+				}
+				if idSelector != "" {
+					id, err = resolveID("alert", idSelector, idItems, false)
+				} else {
+					id, err = resolveName("alert", id, idItems, false)
+				}
+			} else if idSelector == "" {
+				// --id goes to the API as given when it can't be listed
+				err = nil
+			}
+			if err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+		}
+
 		result, err := client.AlertShow(ctx, app, id)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -189,37 +256,45 @@ var alertsAlertShowCmd = &cobra.Command{
 
 func initalertsAlertShowCmd() {
 
-	alertsAlertShowCmd.Flags().StringP("app", "a", "", "app parameter")
+	alertsAlertShowCmd.Flags().StringP("id", "i", "", "Alert ID, name or unique prefix")
 
-	alertsAlertShowCmd.Flags().StringP("id", "i", "", "id parameter")
+	alertsAlertShowCmd.Flags().String("alert", "", "Alert to use instead of --id: latest, or its ID, name or unique prefix")
+
+	alertsAlertShowCmd.MarkFlagsMutuallyExclusive("id", "alert")
 
-	alertsAlertShowCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var alertsAlertUpdateCmd = &cobra.Command{
 	Use:   "alert-update",
 	Short: "Alerts alert-update",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["alerts alert-update"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		client, err := newClient(ctx)
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		app, _ := cmd.Flags().GetString("app")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		id, _ := cmd.Flags().GetString("id")
 
@@ -235,6 +310,39 @@ var alertsAlertUpdateCmd = &cobra.Command{
 
 		notifiersFlag, _ := cmd.Flags().GetStringSlice("notifiers")
 
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		// Resolve --id or --alert from AlertsList, IDs being used as is
+		idSelector, _ := cmd.Flags().GetString("alert")
+		if looksLikeID(idSelector) {
+			id = idSelector
+		} else if idSelector != "" || id != "" && !looksLikeID(id) {
+			idList, err := client.AlertsList(ctx, app)
+			if err == nil {
+				idItems := make([]resolveItem, len(idList))
+				for i, item := range idList {
+					idItems[i].ID = item.ID
+					idItems[i].Names = []string{item.Name}
+					idItems[i].CreatedAt = item.CreatedAt
+				}
+				if idSelector != "" {
+					id, err = resolveID("alert", idSelector, idItems, false)
+				} else {
+					id, err = resolveName("alert", id, idItems, false)
+				}
+			} else if idSelector == "" {
+				// --id goes to the API as given when it can't be listed
+				err = nil
+			}
+			if err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+		}
+
 		params := scalingo.AlertUpdateParams{
 			ContainerType: &containerTypeFlag,
 			Metric:        &metricFlag,
@@ -246,16 +354,16 @@ var alertsAlertUpdateCmd = &cobra.Command{
 
 		result, err := client.AlertUpdate(ctx, app, id, params)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -263,9 +371,9 @@ var alertsAlertUpdateCmd = &cobra.Command{
 
 func initalertsAlertUpdateCmd() {
 
-	alertsAlertUpdateCmd.Flags().StringP("app", "a", "", "app parameter")
+	alertsAlertUpdateCmd.Flags().StringP("id", "i", "", "Alert ID, name or unique prefix")
 
-	alertsAlertUpdateCmd.Flags().StringP("id", "i", "", "id parameter")
+	alertsAlertUpdateCmd.Flags().String("alert", "", "Alert to use instead of --id: latest, or its ID, name or unique prefix")
 
 	alertsAlertUpdateCmd.Flags().String("container-type", "", "ContainerType field")
 
@@ -279,39 +387,76 @@ func initalertsAlertUpdateCmd() {
 
 	alertsAlertUpdateCmd.Flags().StringSlice("notifiers", nil, "Notifiers field")
 
-	alertsAlertUpdateCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
+	alertsAlertUpdateCmd.MarkFlagsMutuallyExclusive("id", "alert")
+
 }
 
 var alertsAlertRemoveCmd = &cobra.Command{
 	Use:   "alert-remove",
 	Short: "Alerts alert-remove",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["alerts alert-remove"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		app, _ := cmd.Flags().GetString("app")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		id, _ := cmd.Flags().GetString("id")
 
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		// Resolve --id or --alert from AlertsList, IDs being used as is
+		idSelector, _ := cmd.Flags().GetString("alert")
+		if looksLikeID(idSelector) {
+			id = idSelector
+		} else if idSelector != "" || id != "" && !looksLikeID(id) {
+			idList, err := client.AlertsList(ctx, app)
+			if err == nil {
+				idItems := make([]resolveItem, len(idList))
+				for i, item := range idList {
+					idItems[i].ID = item.ID
+					idItems[i].Names = []string{item.Name}
+					idItems[i].CreatedAt = item.CreatedAt
+				}
+				if idSelector != "" {
+					id, err = resolveID("alert", idSelector, idItems, true)
+				} else {
+					id, err = resolveName("alert", id, idItems, true)
+				}
+			} else if idSelector == "" {
+				// --id goes to the API as given when it can't be listed
+				err = nil
+			}
+			if err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+		}
+
 		if err := client.AlertRemove(ctx, app, id); err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(render.RenderSuccess("alert-remove completed successfully"))
+		fmt.Fprintln(cmd.OutOrStdout(), render.RenderSuccess("alert-remove completed successfully"))
 
 		return nil
 	},
@@ -319,11 +464,12 @@ var alertsAlertRemoveCmd = &cobra.Command{
 
 func initalertsAlertRemoveCmd() {
 
-	alertsAlertRemoveCmd.Flags().StringP("app", "a", "", "app parameter")
+	alertsAlertRemoveCmd.Flags().StringP("id", "i", "", "Alert ID or name")
+
+	alertsAlertRemoveCmd.Flags().String("alert", "", "Alert to use instead of --id: latest, or its ID or name")
 
-	alertsAlertRemoveCmd.Flags().StringP("id", "i", "", "id parameter")
+	alertsAlertRemoveCmd.MarkFlagsMutuallyExclusive("id", "alert")
 
-	alertsAlertRemoveCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 // RegisterAlertsServiceCommands registers all generated commands with the parent
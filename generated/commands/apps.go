@@ -2,7 +2,6 @@
 package commands
 
 import (
-	"context"
 	"fmt"
 
 	scalingo "github.com/Scalingo/go-scalingo/v8"
@@ -16,37 +15,53 @@ var appsListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "Apps list",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["apps list"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		result, err := client.AppsList(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		if browsing(cmd) {
+			if err := browse(cmd, hooks, result); err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+			return nil
+		}
+
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -54,46 +69,59 @@ var appsListCmd = &cobra.Command{
 
 func initappsListCmd() {
 
-	appsListCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
+	addListFlags(appsListCmd)
+
 }
 
 var appsShowCmd = &cobra.Command{
 	Use:   "show",
 	Short: "Apps show",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["apps show"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		appName, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		appName, _ := cmd.Flags().GetString("app-name")
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		result, err := client.AppsShow(ctx, appName)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -101,41 +129,46 @@ var appsShowCmd = &cobra.Command{
 
 func initappsShowCmd() {
 
-	appsShowCmd.Flags().String("app-name", "", "appName parameter")
-
-	appsShowCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var appsDestroyCmd = &cobra.Command{
 	Use:   "destroy",
 	Short: "Apps destroy",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["apps destroy"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		name, _ := cmd.Flags().GetString("name")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		currentName, _ := cmd.Flags().GetString("current-name")
 
-		if err := client.AppsDestroy(ctx, name, currentName); err != nil {
-			fmt.Println(render.RenderError(err))
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		if err := client.AppsDestroy(ctx, app, currentName); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(render.RenderSuccess("destroy completed successfully"))
+		fmt.Fprintln(cmd.OutOrStdout(), render.RenderSuccess("destroy completed successfully"))
 
 		return nil
 	},
@@ -143,52 +176,61 @@ var appsDestroyCmd = &cobra.Command{
 
 func initappsDestroyCmd() {
 
-	appsDestroyCmd.Flags().StringP("name", "n", "", "name parameter")
-
 	appsDestroyCmd.Flags().String("current-name", "", "currentName parameter")
 
-	appsDestroyCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var appsRenameCmd = &cobra.Command{
 	Use:   "rename",
 	Short: "Apps rename",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["apps rename"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		client, err := newClient(ctx)
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		name, _ := cmd.Flags().GetString("name")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		newName, _ := cmd.Flags().GetString("new-name")
 
-		result, err := client.AppsRename(ctx, name, newName)
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		result, err := client.AppsRename(ctx, app, newName)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -196,52 +238,61 @@ var appsRenameCmd = &cobra.Command{
 
 func initappsRenameCmd() {
 
-	appsRenameCmd.Flags().StringP("name", "n", "", "name parameter")
-
 	appsRenameCmd.Flags().String("new-name", "", "newName parameter")
 
-	appsRenameCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var appsTransferCmd = &cobra.Command{
 	Use:   "transfer",
 	Short: "Apps transfer",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["apps transfer"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		client, err := newClient(ctx)
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		name, _ := cmd.Flags().GetString("name")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		email, _ := cmd.Flags().GetString("email")
 
-		result, err := client.AppsTransfer(ctx, name, email)
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		result, err := client.AppsTransfer(ctx, app, email)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -249,52 +300,89 @@ var appsTransferCmd = &cobra.Command{
 
 func initappsTransferCmd() {
 
-	appsTransferCmd.Flags().StringP("name", "n", "", "name parameter")
-
 	appsTransferCmd.Flags().String("email", "", "email parameter")
 
-	appsTransferCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var appsSetStackCmd = &cobra.Command{
 	Use:   "set-stack",
 	Short: "Apps set-stack",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["apps set-stack"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		name, _ := cmd.Flags().GetString("name")
+		stackID, _ := cmd.Flags().GetString("stack-id")
+
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		stackID, _ := cmd.Flags().GetString("stack-i-d")
+		// Resolve --stack-id or --stack from StacksList, IDs being used as is
+		stackIDSelector, _ := cmd.Flags().GetString("stack")
+		if looksLikeID(stackIDSelector) {
+			stackID = stackIDSelector
+		} else if stackIDSelector != "" || stackID != "" && !looksLikeID(stackID) {
+			stackIDList, err := client.StacksList(ctx)
+			if err == nil {
+				stackIDItems := make([]resolveItem, len(stackIDList))
+				for i, item := range stackIDList {
+					stackIDItems[i].ID = item.ID
+					stackIDItems[i].Names = []string{item.Name}
+					stackIDItems[i].CreatedAt = item.CreatedAt
+				}
+				if stackIDSelector != "" {
+					stackID, err = resolveID("stack", stackIDSelector, stackIDItems, false)
+				} else {
+					stackID, err = resolveName("stack", stackID, stackIDItems, false)
+				}
+			} else if stackIDSelector == "" {
+				// --stack-id goes to the API as given when it can't be listed
+				err = nil
+			}
+			if err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+		}
 
-		result, err := client.AppsSetStack(ctx, name, stackID)
+		result, err := client.AppsSetStack(ctx, app, stackID)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -302,56 +390,61 @@ var appsSetStackCmd = &cobra.Command{
 
 func initappsSetStackCmd() {
 
-	appsSetStackCmd.Flags().StringP("name", "n", "", "name parameter")
+	appsSetStackCmd.Flags().String("stack-id", "", "Stack ID, name or unique prefix")
+
+	appsSetStackCmd.Flags().String("stack", "", "Stack to use instead of --stack-id: latest, or its ID, name or unique prefix")
 
-	appsSetStackCmd.Flags().String("stack-i-d", "", "stackID parameter")
+	appsSetStackCmd.MarkFlagsMutuallyExclusive("stack-id", "stack")
 
-	appsSetStackCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var appsRestartCmd = &cobra.Command{
 	Use:   "restart",
 	Short: "Apps restart",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["apps restart"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
-
-		app, _ := cmd.Flags().GetString("app")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		scopeFlag, _ := cmd.Flags().GetStringSlice("scope")
 
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
 		scope := &scalingo.AppsRestartParams{
 			Scope: scopeFlag,
 		}
 
 		result, err := client.AppsRestart(ctx, app, scope)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		if err := render.StreamResponse(ctx, cmd.OutOrStdout(), cmd.ErrOrStderr(), result); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
 
 		return nil
 	},
@@ -359,43 +452,48 @@ var appsRestartCmd = &cobra.Command{
 
 func initappsRestartCmd() {
 
-	appsRestartCmd.Flags().StringP("app", "a", "", "app parameter")
-
 	appsRestartCmd.Flags().StringSlice("scope", nil, "Scope field")
 
-	appsRestartCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var appsCreateCmd = &cobra.Command{
 	Use:   "create",
 	Short: "Apps create",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["apps create"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		client, err := newClient(ctx)
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		nameFlag, _ := cmd.Flags().GetString("name")
 
 		parentAppFlag, _ := cmd.Flags().GetString("parent-app")
 
-		stackIDFlag, _ := cmd.Flags().GetString("stack---a-c-r0--")
+		stackIDFlag, _ := cmd.Flags().GetString("stack-id")
 
-		projectIDFlag, _ := cmd.Flags().GetString("project---a-c-r0--")
+		projectIDFlag, _ := cmd.Flags().GetString("project-id")
+
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		opts := scalingo.AppsCreateOpts{
 			Name:      nameFlag,
@@ -406,16 +504,16 @@ var appsCreateCmd = &cobra.Command{
 
 		result, err := client.AppsCreate(ctx, opts)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -427,50 +525,61 @@ func initappsCreateCmd() {
 
 	appsCreateCmd.Flags().String("parent-app", "", "ParentApp field")
 
-	appsCreateCmd.Flags().String("stack---a-c-r0--", "", "StackID field")
+	appsCreateCmd.Flags().String("stack-id", "", "StackID field")
 
-	appsCreateCmd.Flags().String("project---a-c-r0--", "", "ProjectID field")
+	appsCreateCmd.Flags().String("project-id", "", "ProjectID field")
 
-	appsCreateCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var appsStatsCmd = &cobra.Command{
 	Use:   "stats",
 	Short: "Apps stats",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
+
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["apps stats"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		authToken, err := config.C.LoadAuth()
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		app, _ := cmd.Flags().GetString("app")
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		result, err := client.AppsStats(ctx, app)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -478,48 +587,65 @@ var appsStatsCmd = &cobra.Command{
 
 func initappsStatsCmd() {
 
-	appsStatsCmd.Flags().StringP("app", "a", "", "app parameter")
-
-	appsStatsCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var appsContainerTypesCmd = &cobra.Command{
 	Use:   "container-types",
 	Short: "Apps container-types",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
+
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["apps container-types"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		authToken, err := config.C.LoadAuth()
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		app, _ := cmd.Flags().GetString("app")
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		result, err := client.AppsContainerTypes(ctx, app)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		if browsing(cmd) {
+			if err := browse(cmd, hooks, result); err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+			return nil
+		}
+
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -527,48 +653,67 @@ var appsContainerTypesCmd = &cobra.Command{
 
 func initappsContainerTypesCmd() {
 
-	appsContainerTypesCmd.Flags().StringP("app", "a", "", "app parameter")
+	addListFlags(appsContainerTypesCmd)
 
-	appsContainerTypesCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var appsContainersPsCmd = &cobra.Command{
 	Use:   "containers-ps",
 	Short: "Apps containers-ps",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["apps containers-ps"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		app, _ := cmd.Flags().GetString("app")
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		result, err := client.AppsContainersPs(ctx, app)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		if browsing(cmd) {
+			if err := browse(cmd, hooks, result); err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+			return nil
+		}
+
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -576,50 +721,53 @@ var appsContainersPsCmd = &cobra.Command{
 
 func initappsContainersPsCmd() {
 
-	appsContainersPsCmd.Flags().StringP("app", "a", "", "app parameter")
+	addListFlags(appsContainersPsCmd)
 
-	appsContainersPsCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var appsScaleCmd = &cobra.Command{
 	Use:   "scale",
 	Short: "Apps scale",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["apps scale"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		app, _ := cmd.Flags().GetString("app")
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		params := &scalingo.AppsScaleParams{}
 
 		result, err := client.AppsScale(ctx, app, params)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		if err := render.StreamResponse(ctx, cmd.OutOrStdout(), cmd.ErrOrStderr(), result); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
 
 		return nil
 	},
@@ -627,50 +775,60 @@ var appsScaleCmd = &cobra.Command{
 
 func initappsScaleCmd() {
 
-	appsScaleCmd.Flags().StringP("app", "a", "", "app parameter")
-
-	appsScaleCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var appsForceHTTPSCmd = &cobra.Command{
-	Use:   "force-h-t-t-p-s",
-	Short: "Apps force-h-t-t-p-s",
+	Use:     "force-https",
+	Aliases: []string{"force-h-t-t-p-s"},
+	Short:   "Apps force-https",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["apps force-https"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		client, err := newClient(ctx)
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		name, _ := cmd.Flags().GetString("name")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		enable, _ := cmd.Flags().GetBool("enable")
 
-		result, err := client.AppsForceHTTPS(ctx, name, enable)
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		result, err := client.AppsForceHTTPS(ctx, app, enable)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -678,52 +836,61 @@ var appsForceHTTPSCmd = &cobra.Command{
 
 func initappsForceHTTPSCmd() {
 
-	appsForceHTTPSCmd.Flags().StringP("name", "n", "", "name parameter")
-
 	appsForceHTTPSCmd.Flags().Bool("enable", false, "enable parameter")
 
-	appsForceHTTPSCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var appsStickySessionCmd = &cobra.Command{
 	Use:   "sticky-session",
 	Short: "Apps sticky-session",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["apps sticky-session"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		client, err := newClient(ctx)
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		name, _ := cmd.Flags().GetString("name")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		enable, _ := cmd.Flags().GetBool("enable")
 
-		result, err := client.AppsStickySession(ctx, name, enable)
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		result, err := client.AppsStickySession(ctx, app, enable)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -731,52 +898,61 @@ var appsStickySessionCmd = &cobra.Command{
 
 func initappsStickySessionCmd() {
 
-	appsStickySessionCmd.Flags().StringP("name", "n", "", "name parameter")
-
 	appsStickySessionCmd.Flags().Bool("enable", false, "enable parameter")
 
-	appsStickySessionCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var appsRouterLogsCmd = &cobra.Command{
 	Use:   "router-logs",
 	Short: "Apps router-logs",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["apps router-logs"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		client, err := newClient(ctx)
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		name, _ := cmd.Flags().GetString("name")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		enable, _ := cmd.Flags().GetBool("enable")
 
-		result, err := client.AppsRouterLogs(ctx, name, enable)
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		result, err := client.AppsRouterLogs(ctx, app, enable)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -784,11 +960,8 @@ var appsRouterLogsCmd = &cobra.Command{
 
 func initappsRouterLogsCmd() {
 
-	appsRouterLogsCmd.Flags().StringP("name", "n", "", "name parameter")
-
 	appsRouterLogsCmd.Flags().Bool("enable", false, "enable parameter")
 
-	appsRouterLogsCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 // RegisterAppsServiceCommands registers all generated commands with the parent
@@ -2,7 +2,6 @@
 package commands
 
 import (
-	"context"
 	"fmt"
 
 	scalingo "github.com/Scalingo/go-scalingo/v8"
@@ -16,39 +15,59 @@ var autoscalersListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "Autoscalers list",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["autoscalers list"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		app, _ := cmd.Flags().GetString("app")
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		result, err := client.AutoscalersList(ctx, app)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		if browsing(cmd) {
+			if err := browse(cmd, hooks, result); err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+			return nil
+		}
+
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -56,35 +75,41 @@ var autoscalersListCmd = &cobra.Command{
 
 func initautoscalersListCmd() {
 
-	autoscalersListCmd.Flags().StringP("app", "a", "", "app parameter")
+	addListFlags(autoscalersListCmd)
 
-	autoscalersListCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var autoscalersAutoscalerAddCmd = &cobra.Command{
 	Use:   "autoscaler-add",
 	Short: "Autoscalers autoscaler-add",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["autoscalers autoscaler-add"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		client, err := newClient(ctx)
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		app, _ := cmd.Flags().GetString("app")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		containerTypeFlag, _ := cmd.Flags().GetString("container-type")
 
@@ -96,6 +121,11 @@ var autoscalersAutoscalerAddCmd = &cobra.Command{
 
 		maxContainersFlag, _ := cmd.Flags().GetInt("max-containers")
 
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
 		params := scalingo.AutoscalerAddParams{
 			ContainerType: containerTypeFlag,
 			Metric:        metricFlag,
@@ -106,16 +136,16 @@ var autoscalersAutoscalerAddCmd = &cobra.Command{
 
 		result, err := client.AutoscalerAdd(ctx, app, params)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -123,8 +153,6 @@ var autoscalersAutoscalerAddCmd = &cobra.Command{
 
 func initautoscalersAutoscalerAddCmd() {
 
-	autoscalersAutoscalerAddCmd.Flags().StringP("app", "a", "", "app parameter")
-
 	autoscalersAutoscalerAddCmd.Flags().String("container-type", "", "ContainerType field")
 
 	autoscalersAutoscalerAddCmd.Flags().String("metric", "", "Metric field")
@@ -135,39 +163,74 @@ func initautoscalersAutoscalerAddCmd() {
 
 	autoscalersAutoscalerAddCmd.Flags().Int("max-containers", 0, "MaxContainers field")
 
-	autoscalersAutoscalerAddCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var autoscalersAutoscalerRemoveCmd = &cobra.Command{
 	Use:   "autoscaler-remove",
 	Short: "Autoscalers autoscaler-remove",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["autoscalers autoscaler-remove"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		app, _ := cmd.Flags().GetString("app")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		id, _ := cmd.Flags().GetString("id")
 
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		// Resolve --id or --autoscaler from AutoscalersList, IDs being used as is
+		idSelector, _ := cmd.Flags().GetString("autoscaler")
+		if looksLikeID(idSelector) {
+			id = idSelector
+		} else if idSelector != "" || id != "" && !looksLikeID(id) {
+			idList, err := client.AutoscalersList(ctx, app)
+			if err == nil {
+				idItems := make([]resolveItem, len(idList))
+				for i, item := range idList {
+					idItems[i].ID = item.ID
+					idItems[i].Names = []string{item.Name}
+					idItems[i].CreatedAt = item.CreatedAt
+				}
+				if idSelector != "" {
+					id, err = resolveID("autoscaler", idSelector, idItems, true)
+				} else {
+					id, err = resolveName("autoscaler", id, idItems, true)
+				}
+			} else if idSelector == "" {
+				// --id goes to the API as given when it can't be listed
+				err = nil
+			}
+			if err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+		}
+
 		if err := client.AutoscalerRemove(ctx, app, id); err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(render.RenderSuccess("autoscaler-remove completed successfully"))
+		fmt.Fprintln(cmd.OutOrStdout(), render.RenderSuccess("autoscaler-remove completed successfully"))
 
 		return nil
 	},
@@ -175,11 +238,12 @@ var autoscalersAutoscalerRemoveCmd = &cobra.Command{
 
 func initautoscalersAutoscalerRemoveCmd() {
 
-	autoscalersAutoscalerRemoveCmd.Flags().StringP("app", "a", "", "app parameter")
+	autoscalersAutoscalerRemoveCmd.Flags().StringP("id", "i", "", "Autoscaler ID or name")
+
+	autoscalersAutoscalerRemoveCmd.Flags().String("autoscaler", "", "Autoscaler to use instead of --id: latest, or its ID or name")
 
-	autoscalersAutoscalerRemoveCmd.Flags().StringP("id", "i", "", "id parameter")
+	autoscalersAutoscalerRemoveCmd.MarkFlagsMutuallyExclusive("id", "autoscaler")
 
-	autoscalersAutoscalerRemoveCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 // RegisterAutoscalersServiceCommands registers all generated commands with the parent
@@ -2,10 +2,8 @@
 package commands
 
 import (
-	"context"
 	"fmt"
 
-	scalingo "github.com/Scalingo/go-scalingo/v8"
 	"github.com/spf13/cobra"
 
 	"generative-cli/config"
@@ -16,41 +14,89 @@ var backupsBackupListCmd = &cobra.Command{
 	Use:   "backup-list",
 	Short: "Backups backup-list",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["backups backup-list"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		outputFormat, err := selectedFormat()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		app, err := config.C.RequireApp()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		addonID, _ := cmd.Flags().GetString("addon-id")
 
-		app, _ := cmd.Flags().GetString("app")
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		addonID, _ := cmd.Flags().GetString("addon-i-d")
+		// Resolve --addon-id or --addon from AddonsList, IDs being used as is
+		addonIDSelector, _ := cmd.Flags().GetString("addon")
+		if looksLikeID(addonIDSelector) {
+			addonID = addonIDSelector
+		} else if addonIDSelector != "" || addonID != "" && !looksLikeID(addonID) {
+			addonIDList, err := client.AddonsList(ctx, app)
+			if err == nil {
+				addonIDItems := make([]resolveItem, len(addonIDList))
+				for i, item := range addonIDList {
+					addonIDItems[i].ID = item.ID
+					addonIDItems[i].Names = []string{item.Name}
+					addonIDItems[i].CreatedAt = item.CreatedAt
+				}
+				if addonIDSelector != "" {
+					addonID, err = resolveID("addon", addonIDSelector, addonIDItems, false)
+				} else {
+					addonID, err = resolveName("addon", addonID, addonIDItems, false)
+				}
+			} else if addonIDSelector == "" {
+				// --addon-id goes to the API as given when it can't be listed
+				err = nil
+			}
+			if err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+		}
 
 		result, err := client.BackupList(ctx, app, addonID)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		if browsing(cmd) {
+			if err := browse(cmd, hooks, result); err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+			return nil
+		}
+
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -58,52 +104,95 @@ var backupsBackupListCmd = &cobra.Command{
 
 func initbackupsBackupListCmd() {
 
-	backupsBackupListCmd.Flags().StringP("app", "a", "", "app parameter")
+	backupsBackupListCmd.Flags().String("addon-id", "", "Addon ID, name or unique prefix")
+
+	backupsBackupListCmd.Flags().String("addon", "", "Addon to use instead of --addon-id: latest, or its ID, name or unique prefix")
+
+	addListFlags(backupsBackupListCmd)
 
-	backupsBackupListCmd.Flags().String("addon-i-d", "", "addonID parameter")
+	backupsBackupListCmd.MarkFlagsMutuallyExclusive("addon-id", "addon")
 
-	backupsBackupListCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var backupsBackupCreateCmd = &cobra.Command{
 	Use:   "backup-create",
 	Short: "Backups backup-create",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["backups backup-create"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		outputFormat, err := selectedFormat()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		app, err := config.C.RequireApp()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		addonID, _ := cmd.Flags().GetString("addon-id")
 
-		app, _ := cmd.Flags().GetString("app")
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		addonID, _ := cmd.Flags().GetString("addon-i-d")
+		// Resolve --addon-id or --addon from AddonsList, IDs being used as is
+		addonIDSelector, _ := cmd.Flags().GetString("addon")
+		if looksLikeID(addonIDSelector) {
+			addonID = addonIDSelector
+		} else if addonIDSelector != "" || addonID != "" && !looksLikeID(addonID) {
+			addonIDList, err := client.AddonsList(ctx, app)
+			if err == nil {
+				addonIDItems := make([]resolveItem, len(addonIDList))
+				for i, item := range addonIDList {
+					addonIDItems[i].ID = item.ID
+					addonIDItems[i].Names = []string{item.Name}
+					addonIDItems[i].CreatedAt = item.CreatedAt
+				}
+				if addonIDSelector != "" {
+					addonID, err = resolveID("addon", addonIDSelector, addonIDItems, false)
+				} else {
+					addonID, err = resolveName("addon", addonID, addonIDItems, false)
+				}
+			} else if addonIDSelector == "" {
+				// --addon-id goes to the API as given when it can't be listed
+				err = nil
+			}
+			if err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+		}
 
 		result, err := client.BackupCreate(ctx, app, addonID)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -111,54 +200,123 @@ var backupsBackupCreateCmd = &cobra.Command{
 
 func initbackupsBackupCreateCmd() {
 
-	backupsBackupCreateCmd.Flags().StringP("app", "a", "", "app parameter")
+	backupsBackupCreateCmd.Flags().String("addon-id", "", "Addon ID, name or unique prefix")
+
+	backupsBackupCreateCmd.Flags().String("addon", "", "Addon to use instead of --addon-id: latest, or its ID, name or unique prefix")
 
-	backupsBackupCreateCmd.Flags().String("addon-i-d", "", "addonID parameter")
+	backupsBackupCreateCmd.MarkFlagsMutuallyExclusive("addon-id", "addon")
 
-	backupsBackupCreateCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var backupsBackupShowCmd = &cobra.Command{
 	Use:   "backup-show",
 	Short: "Backups backup-show",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
+
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["backups backup-show"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		authToken, err := config.C.LoadAuth()
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		addonID, _ := cmd.Flags().GetString("addon-id")
 
-		app, _ := cmd.Flags().GetString("app")
+		backupID, _ := cmd.Flags().GetString("backup-id")
+
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		addonID, _ := cmd.Flags().GetString("addon-i-d")
+		// Resolve --addon-id or --addon from AddonsList, IDs being used as is
+		addonIDSelector, _ := cmd.Flags().GetString("addon")
+		if looksLikeID(addonIDSelector) {
+			addonID = addonIDSelector
+		} else if addonIDSelector != "" || addonID != "" && !looksLikeID(addonID) {
+			addonIDList, err := client.AddonsList(ctx, app)
+			if err == nil {
+				addonIDItems := make([]resolveItem, len(addonIDList))
+				for i, item := range addonIDList {
+					addonIDItems[i].ID = item.ID
+					addonIDItems[i].Names = []string{item.Name}
+					addonIDItems[i].CreatedAt = item.CreatedAt
+				}
+				if addonIDSelector != "" {
+					addonID, err = resolveID("addon", addonIDSelector, addonIDItems, false)
+				} else {
+					addonID, err = resolveName("addon", addonID, addonIDItems, false)
+				}
+			} else if addonIDSelector == "" {
+				// --addon-id goes to the API as given when it can't be listed
+				err = nil
+			}
+			if err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+		}
 
-		backupID, _ := cmd.Flags().GetString("backup-i-d")
+		// Resolve --backup-id or --backup from BackupList, IDs being used as is
+		backupIDSelector, _ := cmd.Flags().GetString("backup")
+		if looksLikeID(backupIDSelector) {
+			backupID = backupIDSelector
+		} else if backupIDSelector != "" || backupID != "" && !looksLikeID(backupID) {
+			backupIDList, err := client.BackupList(ctx, app, addonID)
+			if err == nil {
+				backupIDItems := make([]resolveItem, len(backupIDList))
+				for i, item := range backupIDList {
+					backupIDItems[i].ID = item.ID
+					backupIDItems[i].Names = []string{item.Name}
+					backupIDItems[i].CreatedAt = item.CreatedAt
+				}
+				if backupIDSelector != "" {
+					backupID, err = resolveID("backup", backupIDSelector, backupIDItems, false)
+				} else {
+					backupID, err = resolveName("backup", backupID, backupIDItems, false)
+				}
+			} else if backupIDSelector == "" {
+				// --backup-id goes to the API as given when it can't be listed
+				err = nil
+			}
+			if err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+		}
 
 		result, err := client.BackupShow(ctx, app, addonID, backupID)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -166,56 +324,130 @@ var backupsBackupShowCmd = &cobra.Command{
 
 func initbackupsBackupShowCmd() {
 
-	backupsBackupShowCmd.Flags().StringP("app", "a", "", "app parameter")
+	backupsBackupShowCmd.Flags().String("addon-id", "", "Addon ID, name or unique prefix")
+
+	backupsBackupShowCmd.Flags().String("addon", "", "Addon to use instead of --addon-id: latest, or its ID, name or unique prefix")
+
+	backupsBackupShowCmd.Flags().String("backup-id", "", "Backup ID, name or unique prefix")
 
-	backupsBackupShowCmd.Flags().String("addon-i-d", "", "addonID parameter")
+	backupsBackupShowCmd.Flags().String("backup", "", "Backup to use instead of --backup-id: latest, or its ID, name or unique prefix")
 
-	backupsBackupShowCmd.Flags().String("backup-i-d", "", "backupID parameter")
+	backupsBackupShowCmd.MarkFlagsMutuallyExclusive("addon-id", "addon")
+
+	backupsBackupShowCmd.MarkFlagsMutuallyExclusive("backup-id", "backup")
 
-	backupsBackupShowCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var backupsBackupDownloadURLCmd = &cobra.Command{
-	Use:   "backup-download-u-r-l",
-	Short: "Backups backup-download-u-r-l",
+	Use:     "backup-download-url",
+	Aliases: []string{"backup-download-u-r-l"},
+	Short:   "Backups backup-download-url",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["backups backup-download-url"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		app, _ := cmd.Flags().GetString("app")
+		addonID, _ := cmd.Flags().GetString("addon-id")
 
-		addonID, _ := cmd.Flags().GetString("addon-i-d")
+		backupID, _ := cmd.Flags().GetString("backup-id")
 
-		backupID, _ := cmd.Flags().GetString("backup-i-d")
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		// Resolve --addon-id or --addon from AddonsList, IDs being used as is
+		addonIDSelector, _ := cmd.Flags().GetString("addon")
+		if looksLikeID(addonIDSelector) {
+			addonID = addonIDSelector
+		} else if addonIDSelector != "" || addonID != "" && !looksLikeID(addonID) {
+			addonIDList, err := client.AddonsList(ctx, app)
+			if err == nil {
+				addonIDItems := make([]resolveItem, len(addonIDList))
+				for i, item := range addonIDList {
+					addonIDItems[i].ID = item.ID
+					addonIDItems[i].Names = []string{item.Name}
+					addonIDItems[i].CreatedAt = item.CreatedAt
+				}
+				if addonIDSelector != "" {
+					addonID, err = resolveID("addon", addonIDSelector, addonIDItems, false)
+				} else {
+					addonID, err = resolveName("addon", addonID, addonIDItems, false)
+				}
+			} else if addonIDSelector == "" {
+				// --addon-id goes to the API as given when it can't be listed
+				err = nil
+			}
+			if err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+		}
+
+		// Resolve --backup-id or --backup from BackupList, IDs being used as is
+		backupIDSelector, _ := cmd.Flags().GetString("backup")
+		if looksLikeID(backupIDSelector) {
+			backupID = backupIDSelector
+		} else if backupIDSelector != "" || backupID != "" && !looksLikeID(backupID) {
+			backupIDList, err := client.BackupList(ctx, app, addonID)
+			if err == nil {
+				backupIDItems := make([]resolveItem, len(backupIDList))
+				for i, item := range backupIDList {
+					backupIDItems[i].ID = item.ID
+					backupIDItems[i].Names = []string{item.Name}
+					backupIDItems[i].CreatedAt = item.CreatedAt
+				}
+				if backupIDSelector != "" {
+					backupID, err = resolveID("backup", backupIDSelector, backupIDItems, false)
+				} else {
+					backupID, err = resolveName("backup", backupID, backupIDItems, false)
+				}
+			} else if backupIDSelector == "" {
+				// --backup-id goes to the API as given when it can't be listed
+				err = nil
+			}
+			if err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+		}
 
 		result, err := client.BackupDownloadURL(ctx, app, addonID, backupID)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -223,13 +455,18 @@ var backupsBackupDownloadURLCmd = &cobra.Command{
 
 func initbackupsBackupDownloadURLCmd() {
 
-	backupsBackupDownloadURLCmd.Flags().StringP("app", "a", "", "app parameter")
+	backupsBackupDownloadURLCmd.Flags().String("addon-id", "", "Addon ID, name or unique prefix")
+
+	backupsBackupDownloadURLCmd.Flags().String("addon", "", "Addon to use instead of --addon-id: latest, or its ID, name or unique prefix")
+
+	backupsBackupDownloadURLCmd.Flags().String("backup-id", "", "Backup ID, name or unique prefix")
+
+	backupsBackupDownloadURLCmd.Flags().String("backup", "", "Backup to use instead of --backup-id: latest, or its ID, name or unique prefix")
 
-	backupsBackupDownloadURLCmd.Flags().String("addon-i-d", "", "addonID parameter")
+	backupsBackupDownloadURLCmd.MarkFlagsMutuallyExclusive("addon-id", "addon")
 
-	backupsBackupDownloadURLCmd.Flags().String("backup-i-d", "", "backupID parameter")
+	backupsBackupDownloadURLCmd.MarkFlagsMutuallyExclusive("backup-id", "backup")
 
-	backupsBackupDownloadURLCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 // RegisterBackupsServiceCommands registers all generated commands with the parent
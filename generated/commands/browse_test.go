@@ -0,0 +1,135 @@
+package commands
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	scalingo "github.com/Scalingo/go-scalingo/v8"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"generative-cli/config"
+	"generative-cli/render"
+)
+
+// useAppFlag declares the root --app flag the CLI binds to config.C.AppFlag,
+// which row actions on apps set
+func useAppFlag(t *testing.T) {
+	t.Helper()
+	resetFlags(t, []string{"apps", "list"})
+	if testRoot.PersistentFlags().Lookup("app") == nil {
+		testRoot.PersistentFlags().StringVarP(&config.C.AppFlag, "app", "a", "", "App name")
+	}
+}
+
+// browseApps opens the table browser of apps list on the apps of listClient
+func browseApps(t *testing.T) (*render.Browser, *fakeClient) {
+	t.Helper()
+	useAppFlag(t)
+	fake := useFakeClient(t)
+	newClient = func(ctx context.Context) (Client, error) {
+		return listClient{fake}, nil
+	}
+	resetFlags(t, []string{"apps", "list"}, "sort-by", "filter", "interactive")
+	cmd, _, err := testRoot.Find([]string{"apps", "list"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd.SetContext(context.Background())
+	apps, _ := listClient{fake}.AppsList(cmd.Context())
+	fake.calls = nil
+
+	browser, err := newBrowser(cmd, nil, apps)
+	if err != nil {
+		t.Fatal(err)
+	}
+	browser.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	return browser, fake
+}
+
+// press sends keys to a browser, running the commands they return until
+// they are done
+func press(browser *render.Browser, keys ...string) {
+	for _, k := range keys {
+		msg := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)}
+		switch k {
+		case "enter":
+			msg = tea.KeyMsg{Type: tea.KeyEnter}
+		case "esc":
+			msg = tea.KeyMsg{Type: tea.KeyEsc}
+		case "down":
+			msg = tea.KeyMsg{Type: tea.KeyDown}
+		}
+		_, cmd := browser.Update(msg)
+		for cmd != nil {
+			_, cmd = browser.Update(cmd())
+		}
+	}
+}
+
+// selectedName returns the name of the selected app, "" when there is none
+func selectedName(browser *render.Browser) string {
+	app, ok := browser.Selected().(*scalingo.App)
+	if !ok {
+		return ""
+	}
+	return app.Name
+}
+
+func TestBrowseShow(t *testing.T) {
+	useDetailApp(t)
+	browser, fake := browseApps(t)
+
+	press(browser, "down", "enter")
+
+	calls := fake.callsTo("AppsShow")
+	if len(calls) != 1 || calls[0].Args[0] != "api" {
+		t.Fatalf("expected apps show of api, got %+v", fake.calls)
+	}
+	if out := browser.Output(); !strings.Contains(out, "alice") {
+		t.Errorf("expected the detail view of the app, got %q", out)
+	}
+	if config.C.AppFlag != testApp {
+		t.Errorf("expected --app restored to %q, got %q", testApp, config.C.AppFlag)
+	}
+
+	press(browser, "esc")
+	if out := browser.Output(); out != "" {
+		t.Errorf("expected the table back, got %q", out)
+	}
+	if name := selectedName(browser); name != "api" {
+		t.Errorf("expected api still selected, got %q", name)
+	}
+}
+
+func TestBrowseConfirmAction(t *testing.T) {
+	browser, fake := browseApps(t)
+
+	press(browser, "s")
+	if !strings.Contains(browser.View(), "Run test apps stats --app web-b? (y/N)") {
+		t.Fatalf("expected a confirmation, got %q", browser.View())
+	}
+	press(browser, "n")
+	if len(fake.calls) != 0 {
+		t.Fatalf("expected no call once cancelled, got %+v", fake.calls)
+	}
+
+	press(browser, "s", "y")
+	calls := fake.callsTo("AppsStats")
+	if len(calls) != 1 || calls[0].Args[0] != "web-b" {
+		t.Errorf("expected apps stats of web-b, got %+v", fake.calls)
+	}
+}
+
+func TestBrowseFlag(t *testing.T) {
+	resetFlags(t, []string{"apps", "list"})
+	for _, path := range [][]string{{"apps", "list"}, {"alerts", "list"}, {"scm-integrations", "import-keys"}} {
+		cmd, _, err := testRoot.Find(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if flag := cmd.Flags().Lookup("interactive"); flag == nil || flag.Shorthand != "i" {
+			t.Errorf("expected %v to have --interactive/-i, got %+v", path, flag)
+		}
+	}
+}
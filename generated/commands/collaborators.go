@@ -2,7 +2,6 @@
 package commands
 
 import (
-	"context"
 	"fmt"
 
 	scalingo "github.com/Scalingo/go-scalingo/v8"
@@ -16,39 +15,59 @@ var collaboratorsListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "Collaborators list",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["collaborators list"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		app, _ := cmd.Flags().GetString("app")
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		result, err := client.CollaboratorsList(ctx, app)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		if browsing(cmd) {
+			if err := browse(cmd, hooks, result); err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+			return nil
+		}
+
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -56,40 +75,51 @@ var collaboratorsListCmd = &cobra.Command{
 
 func initcollaboratorsListCmd() {
 
-	collaboratorsListCmd.Flags().StringP("app", "a", "", "app parameter")
+	addListFlags(collaboratorsListCmd)
 
-	collaboratorsListCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var collaboratorsCollaboratorAddCmd = &cobra.Command{
 	Use:   "collaborator-add",
 	Short: "Collaborators collaborator-add",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["collaborators collaborator-add"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		client, err := newClient(ctx)
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		app, _ := cmd.Flags().GetString("app")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		emailFlag, _ := cmd.Flags().GetString("email")
 
 		isLimitedFlag, _ := cmd.Flags().GetBool("is-limited")
 
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
 		params := scalingo.CollaboratorAddParams{
 			Email:     emailFlag,
 			IsLimited: isLimitedFlag,
@@ -97,16 +127,16 @@ var collaboratorsCollaboratorAddCmd = &cobra.Command{
 
 		result, err := client.CollaboratorAdd(ctx, app, params)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -114,45 +144,78 @@ var collaboratorsCollaboratorAddCmd = &cobra.Command{
 
 func initcollaboratorsCollaboratorAddCmd() {
 
-	collaboratorsCollaboratorAddCmd.Flags().StringP("app", "a", "", "app parameter")
-
 	collaboratorsCollaboratorAddCmd.Flags().String("email", "", "Email field")
 
 	collaboratorsCollaboratorAddCmd.Flags().Bool("is-limited", false, "IsLimited field")
 
-	collaboratorsCollaboratorAddCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var collaboratorsCollaboratorRemoveCmd = &cobra.Command{
 	Use:   "collaborator-remove",
 	Short: "Collaborators collaborator-remove",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["collaborators collaborator-remove"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		app, err := config.C.RequireApp()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		app, _ := cmd.Flags().GetString("app")
+		collaboratorID, _ := cmd.Flags().GetString("collaborator-id")
 
-		collaboratorID, _ := cmd.Flags().GetString("collaborator-i-d")
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		// Resolve --collaborator-id or --collaborator from CollaboratorsList, IDs being used as is
+		collaboratorIDSelector, _ := cmd.Flags().GetString("collaborator")
+		if looksLikeID(collaboratorIDSelector) {
+			collaboratorID = collaboratorIDSelector
+		} else if collaboratorIDSelector != "" || collaboratorID != "" && !looksLikeID(collaboratorID) {
+			collaboratorIDList, err := client.CollaboratorsList(ctx, app)
+			if err == nil {
+				collaboratorIDItems := make([]resolveItem, len(collaboratorIDList))
+				for i, item := range collaboratorIDList {
+					collaboratorIDItems[i].ID = item.ID
+					collaboratorIDItems[i].Names = []string{item.Name}
+					collaboratorIDItems[i].CreatedAt = item.CreatedAt
+				}
+				if collaboratorIDSelector != "" {
+					collaboratorID, err = resolveID("collaborator", collaboratorIDSelector, collaboratorIDItems, true)
+				} else {
+					collaboratorID, err = resolveName("collaborator", collaboratorID, collaboratorIDItems, true)
+				}
+			} else if collaboratorIDSelector == "" {
+				// --collaborator-id goes to the API as given when it can't be listed
+				err = nil
+			}
+			if err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+		}
 
 		if err := client.CollaboratorRemove(ctx, app, collaboratorID); err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(render.RenderSuccess("collaborator-remove completed successfully"))
+		fmt.Fprintln(cmd.OutOrStdout(), render.RenderSuccess("collaborator-remove completed successfully"))
 
 		return nil
 	},
@@ -160,58 +223,99 @@ var collaboratorsCollaboratorRemoveCmd = &cobra.Command{
 
 func initcollaboratorsCollaboratorRemoveCmd() {
 
-	collaboratorsCollaboratorRemoveCmd.Flags().StringP("app", "a", "", "app parameter")
+	collaboratorsCollaboratorRemoveCmd.Flags().String("collaborator-id", "", "Collaborator ID or name")
+
+	collaboratorsCollaboratorRemoveCmd.Flags().String("collaborator", "", "Collaborator to use instead of --collaborator-id: latest, or its ID or name")
 
-	collaboratorsCollaboratorRemoveCmd.Flags().String("collaborator-i-d", "", "collaboratorID parameter")
+	collaboratorsCollaboratorRemoveCmd.MarkFlagsMutuallyExclusive("collaborator-id", "collaborator")
 
-	collaboratorsCollaboratorRemoveCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var collaboratorsCollaboratorUpdateCmd = &cobra.Command{
 	Use:   "collaborator-update",
 	Short: "Collaborators collaborator-update",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["collaborators collaborator-update"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		client, err := newClient(ctx)
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		app, _ := cmd.Flags().GetString("app")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		collaboratorID, _ := cmd.Flags().GetString("collaborator-i-d")
+		collaboratorID, _ := cmd.Flags().GetString("collaborator-id")
 
 		isLimitedFlag, _ := cmd.Flags().GetBool("is-limited")
 
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		// Resolve --collaborator-id or --collaborator from CollaboratorsList, IDs being used as is
+		collaboratorIDSelector, _ := cmd.Flags().GetString("collaborator")
+		if looksLikeID(collaboratorIDSelector) {
+			collaboratorID = collaboratorIDSelector
+		} else if collaboratorIDSelector != "" || collaboratorID != "" && !looksLikeID(collaboratorID) {
+			collaboratorIDList, err := client.CollaboratorsList(ctx, app)
+			if err == nil {
+				collaboratorIDItems := make([]resolveItem, len(collaboratorIDList))
+				for i, item := range collaboratorIDList {
+					collaboratorIDItems[i].ID = item.ID
+					collaboratorIDItems[i].Names = []string{item.Name}
+					collaboratorIDItems[i].CreatedAt = item.CreatedAt
+				}
+				if collaboratorIDSelector != "" {
+					collaboratorID, err = resolveID("collaborator", collaboratorIDSelector, collaboratorIDItems, false)
+				} else {
+					collaboratorID, err = resolveName("collaborator", collaboratorID, collaboratorIDItems, false)
+				}
+			} else if collaboratorIDSelector == "" {
+				// --collaborator-id goes to the API as given when it can't be listed
+				err = nil
+			}
+			if err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+		}
+
 		params := scalingo.CollaboratorUpdateParams{
 			IsLimited: isLimitedFlag,
 		}
 
 		result, err := client.CollaboratorUpdate(ctx, app, collaboratorID, params)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -219,13 +323,14 @@ var collaboratorsCollaboratorUpdateCmd = &cobra.Command{
 
 func initcollaboratorsCollaboratorUpdateCmd() {
 
-	collaboratorsCollaboratorUpdateCmd.Flags().StringP("app", "a", "", "app parameter")
+	collaboratorsCollaboratorUpdateCmd.Flags().String("collaborator-id", "", "Collaborator ID, name or unique prefix")
 
-	collaboratorsCollaboratorUpdateCmd.Flags().String("collaborator-i-d", "", "collaboratorID parameter")
+	collaboratorsCollaboratorUpdateCmd.Flags().String("collaborator", "", "Collaborator to use instead of --collaborator-id: latest, or its ID, name or unique prefix")
 
 	collaboratorsCollaboratorUpdateCmd.Flags().Bool("is-limited", false, "IsLimited field")
 
-	collaboratorsCollaboratorUpdateCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
+	collaboratorsCollaboratorUpdateCmd.MarkFlagsMutuallyExclusive("collaborator-id", "collaborator")
+
 }
 
 // RegisterCollaboratorsServiceCommands registers all generated commands with the parent
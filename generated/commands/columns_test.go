@@ -0,0 +1,149 @@
+package commands
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"generative-cli/config"
+	"generative-cli/render"
+)
+
+type columnsOwner struct {
+	Username string `json:"username"`
+	Email    string `json:"email"`
+}
+
+// columnsApp is an app with a nested owner, listed by useColumnsApps
+type columnsApp struct {
+	ID             string        `json:"id"`
+	Name           string        `json:"name"`
+	Region         string        `json:"region"`
+	Owner          *columnsOwner `json:"owner"`
+	LastDeployedBy string        `json:"last_deployed_by"`
+	CreatedAt      time.Time     `json:"created_at"`
+	Instances      *int          `json:"instances"`
+	Domains        []string      `json:"domains"`
+	Labels         map[string]string
+}
+
+func init() {
+	render.RegisterColumns("columnsApp", "name", "owner.username", "removed_field")
+}
+
+// useColumnsApps makes apps list render two columnsApp, the second one without owner
+func useColumnsApps(t *testing.T) {
+	t.Helper()
+	useFakeClient(t)
+	useHooks(t, "apps list", Hooks{
+		AfterCall: func(cmd *cobra.Command, result any) (any, error) {
+			return []columnsApp{
+				{
+					ID: "app-1", Name: "web", Region: "osc-fr1", Owner: &columnsOwner{Username: "alice"}, LastDeployedBy: "bob",
+					CreatedAt: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC), Instances: ptr(2), Domains: []string{"a.io", "b.io"},
+				},
+				{ID: "app-2", Name: "worker", Region: "osc-secnum-fr1"},
+			}, nil
+		},
+	})
+}
+
+// assertColumns checks that out has the want headers and none of the unwanted ones
+func assertColumns(t *testing.T, out string, want, unwanted []string) {
+	t.Helper()
+	for _, header := range want {
+		if !strings.Contains(out, header) {
+			t.Errorf("column %s missing:\n%s", header, out)
+		}
+	}
+	for _, header := range unwanted {
+		if strings.Contains(out, header) {
+			t.Errorf("unexpected column %s:\n%s", header, out)
+		}
+	}
+}
+
+func TestColumnsPreset(t *testing.T) {
+	useColumnsApps(t)
+
+	out := runCommand(t, "apps", "list")
+	assertColumns(t, out, []string{"Name", "Owner.Username", "alice"}, []string{"ID", "Region"})
+}
+
+func TestColumnsSelected(t *testing.T) {
+	useColumnsApps(t)
+	config.C.ColumnsFlag = []string{"region", "Owner.username", "last-deployed-by"}
+
+	out := runCommand(t, "apps", "list")
+	assertColumns(t, out, []string{"Region", "Owner.Username", "LastDeployedBy", "osc-secnum-fr1"}, []string{"Name"})
+	if !strings.Contains(out, " - ") {
+		t.Errorf("missing owner not shown as -:\n%s", out)
+	}
+	if strings.Index(out, "Region") > strings.Index(out, "Owner.Username") {
+		t.Errorf("columns out of order:\n%s", out)
+	}
+}
+
+func TestColumnsWide(t *testing.T) {
+	useColumnsApps(t)
+	config.C.WideFlag = true
+
+	out := runCommand(t, "apps", "list")
+	assertColumns(t, out, []string{"ID", "Region", "Owner.", "Instances", "a.io, b.io"}, []string{"Labels"})
+}
+
+func TestColumnsFormatted(t *testing.T) {
+	useColumnsApps(t)
+	config.C.ColumnsFlag = []string{"name", "created_at", "instances"}
+
+	out := runCommand(t, "apps", "list")
+	assertColumns(t, out, []string{"2026-01-02T03:04:05Z", "│ 2 "}, []string{"0x"})
+}
+
+func TestColumnsSliceLength(t *testing.T) {
+	useFakeClient(t)
+	config.C.ColumnsFlag = []string{"name", "domains"}
+	useHooks(t, "apps list", Hooks{
+		AfterCall: func(cmd *cobra.Command, result any) (any, error) {
+			return []columnsApp{{Name: "web", Domains: strings.Fields("a b c d e f")}}, nil
+		},
+	})
+
+	out := runCommand(t, "apps", "list")
+	assertColumns(t, out, []string{"[6 items]"}, []string{"a, b"})
+}
+
+func TestDetailNestedFields(t *testing.T) {
+	useFakeClient(t)
+	useHooks(t, "apps show", Hooks{
+		AfterCall: func(cmd *cobra.Command, result any) (any, error) {
+			return &columnsApp{Name: "web", Owner: &columnsOwner{Username: "alice"}, CreatedAt: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)}, nil
+		},
+	})
+
+	out := runCommand(t, "apps", "show")
+	assertColumns(t, out, []string{"Owner", "Username:", "alice", "CreatedAt:", "2026-01-02T03:04:05Z", "Instances:", "(nil)"}, []string{"<columnsOwner>", "Owner.Username"})
+}
+
+func TestColumnsUnknown(t *testing.T) {
+	useColumnsApps(t)
+	config.C.ColumnsFlag = []string{"name", "owner.phone"}
+
+	testRoot.SetArgs([]string{"apps", "list"})
+	err := testRoot.Execute()
+	want := `unknown column "owner.phone", available columns: id, name, region, owner.username, owner.email, last_deployed_by, created_at, instances, domains`
+	if err == nil || err.Error() != want {
+		t.Fatalf("error = %v, want %q", err, want)
+	}
+}
+
+func TestColumnsPages(t *testing.T) {
+	useFakeClient(t)
+	resetFlags(t, paginatedCommand, "page", "per-page", "limit", "all")
+	config.C.ColumnsFlag = []string{"id"}
+
+	out := runCommand(t, paginatedCommand...)
+	assertColumns(t, out, []string{"ID"}, []string{"Name"})
+}
@@ -2,13 +2,10 @@
 package commands
 
 import (
-	"context"
 	"fmt"
 
-	scalingo "github.com/Scalingo/go-scalingo/v8"
 	"github.com/spf13/cobra"
 
-	"generative-cli/config"
 	"generative-cli/render"
 )
 
@@ -16,37 +13,53 @@ var containerSizesListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "ContainerSizes list",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["container-sizes list"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		result, err := client.ContainerSizesList(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		if browsing(cmd) {
+			if err := browse(cmd, hooks, result); err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+			return nil
+		}
+
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -54,14 +67,16 @@ var containerSizesListCmd = &cobra.Command{
 
 func initcontainerSizesListCmd() {
 
-	containerSizesListCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
+	addListFlags(containerSizesListCmd)
+
 }
 
 // RegisterContainerSizesServiceCommands registers all generated commands with the parent
 func RegisterContainerSizesServiceCommands(parent *cobra.Command) {
 	serviceCmd := &cobra.Command{
-		Use:   "container_sizes",
-		Short: "ContainerSizesService operations",
+		Use:     "container-sizes",
+		Aliases: []string{"container_sizes"},
+		Short:   "ContainerSizesService operations",
 	}
 
 	initcontainerSizesListCmd()
@@ -2,10 +2,8 @@
 package commands
 
 import (
-	"context"
 	"fmt"
 
-	scalingo "github.com/Scalingo/go-scalingo/v8"
 	"github.com/spf13/cobra"
 
 	"generative-cli/config"
@@ -16,32 +14,68 @@ var containersStopCmd = &cobra.Command{
 	Use:   "stop",
 	Short: "Containers stop",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["containers stop"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		appName, err := config.C.RequireApp()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		appName, _ := cmd.Flags().GetString("app-name")
+		containerID, _ := cmd.Flags().GetString("container-id")
+
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		containerID, _ := cmd.Flags().GetString("container-i-d")
+		// Resolve --container-id or --container from AppsContainersPs, IDs being used as is
+		containerIDSelector, _ := cmd.Flags().GetString("container")
+		if looksLikeID(containerIDSelector) {
+			containerID = containerIDSelector
+		} else if containerIDSelector != "" || containerID != "" && !looksLikeID(containerID) {
+			containerIDList, err := client.AppsContainersPs(ctx, appName)
+			if err == nil {
+				containerIDItems := make([]resolveItem, len(containerIDList))
+				for i, item := range containerIDList {
+					containerIDItems[i].ID = item.ID
+					containerIDItems[i].Names = []string{item.Name}
+					containerIDItems[i].CreatedAt = item.CreatedAt
+				}
+				if containerIDSelector != "" {
+					containerID, err = resolveID("container", containerIDSelector, containerIDItems, false)
+				} else {
+					containerID, err = resolveName("container", containerID, containerIDItems, false)
+				}
+			} else if containerIDSelector == "" {
+				// --container-id goes to the API as given when it can't be listed
+				err = nil
+			}
+			if err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+		}
 
 		if err := client.ContainersStop(ctx, appName, containerID); err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(render.RenderSuccess("stop completed successfully"))
+		fmt.Fprintln(cmd.OutOrStdout(), render.RenderSuccess("stop completed successfully"))
 
 		return nil
 	},
@@ -49,11 +83,12 @@ var containersStopCmd = &cobra.Command{
 
 func initcontainersStopCmd() {
 
-	containersStopCmd.Flags().String("app-name", "", "appName parameter")
+	containersStopCmd.Flags().String("container-id", "", "Container ID, name or unique prefix")
+
+	containersStopCmd.Flags().String("container", "", "Container to use instead of --container-id: latest, or its ID, name or unique prefix")
 
-	containersStopCmd.Flags().String("container-i-d", "", "containerID parameter")
+	containersStopCmd.MarkFlagsMutuallyExclusive("container-id", "container")
 
-	containersStopCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 // RegisterContainersServiceCommands registers all generated commands with the parent
@@ -2,10 +2,8 @@
 package commands
 
 import (
-	"context"
 	"fmt"
 
-	scalingo "github.com/Scalingo/go-scalingo/v8"
 	"github.com/spf13/cobra"
 
 	"generative-cli/config"
@@ -16,39 +14,51 @@ var cronTasksGetCmd = &cobra.Command{
 	Use:   "get",
 	Short: "CronTasks get",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["cron-tasks get"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		app, _ := cmd.Flags().GetString("app")
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		result, err := client.CronTasksGet(ctx, app)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -56,16 +66,14 @@ var cronTasksGetCmd = &cobra.Command{
 
 func initcronTasksGetCmd() {
 
-	cronTasksGetCmd.Flags().StringP("app", "a", "", "app parameter")
-
-	cronTasksGetCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 // RegisterCronTasksServiceCommands registers all generated commands with the parent
 func RegisterCronTasksServiceCommands(parent *cobra.Command) {
 	serviceCmd := &cobra.Command{
-		Use:   "cron_tasks",
-		Short: "CronTasksService operations",
+		Use:     "cron-tasks",
+		Aliases: []string{"cron_tasks"},
+		Short:   "CronTasksService operations",
 	}
 
 	initcronTasksGetCmd()
@@ -2,7 +2,6 @@
 package commands
 
 import (
-	"context"
 	"fmt"
 
 	scalingo "github.com/Scalingo/go-scalingo/v8"
@@ -16,41 +15,81 @@ var databasesDatabaseShowCmd = &cobra.Command{
 	Use:   "database-show",
 	Short: "Databases database-show",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["databases database-show"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		app, _ := cmd.Flags().GetString("app")
+		addonID, _ := cmd.Flags().GetString("addon-id")
 
-		addonID, _ := cmd.Flags().GetString("addon-i-d")
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		// Resolve --addon-id or --addon from AddonsList, IDs being used as is
+		addonIDSelector, _ := cmd.Flags().GetString("addon")
+		if looksLikeID(addonIDSelector) {
+			addonID = addonIDSelector
+		} else if addonIDSelector != "" || addonID != "" && !looksLikeID(addonID) {
+			addonIDList, err := client.AddonsList(ctx, app)
+			if err == nil {
+				addonIDItems := make([]resolveItem, len(addonIDList))
+				for i, item := range addonIDList {
+					addonIDItems[i].ID = item.ID
+					addonIDItems[i].Names = []string{item.Name}
+					addonIDItems[i].CreatedAt = item.CreatedAt
+				}
+				if addonIDSelector != "" {
+					addonID, err = resolveID("addon", addonIDSelector, addonIDItems, false)
+				} else {
+					addonID, err = resolveName("addon", addonID, addonIDItems, false)
+				}
+			} else if addonIDSelector == "" {
+				// --addon-id goes to the API as given when it can't be listed
+				err = nil
+			}
+			if err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+		}
 
 		result, err := client.DatabaseShow(ctx, app, addonID)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -58,54 +97,95 @@ var databasesDatabaseShowCmd = &cobra.Command{
 
 func initdatabasesDatabaseShowCmd() {
 
-	databasesDatabaseShowCmd.Flags().StringP("app", "a", "", "app parameter")
+	databasesDatabaseShowCmd.Flags().String("addon-id", "", "Addon ID, name or unique prefix")
 
-	databasesDatabaseShowCmd.Flags().String("addon-i-d", "", "addonID parameter")
+	databasesDatabaseShowCmd.Flags().String("addon", "", "Addon to use instead of --addon-id: latest, or its ID, name or unique prefix")
+
+	databasesDatabaseShowCmd.MarkFlagsMutuallyExclusive("addon-id", "addon")
 
-	databasesDatabaseShowCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var databasesDatabaseEnableFeatureCmd = &cobra.Command{
 	Use:   "database-enable-feature",
 	Short: "Databases database-enable-feature",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["databases database-enable-feature"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		client, err := newClient(ctx)
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		app, _ := cmd.Flags().GetString("app")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		addonID, _ := cmd.Flags().GetString("addon-i-d")
+		addonID, _ := cmd.Flags().GetString("addon-id")
 
 		feature, _ := cmd.Flags().GetString("feature")
 
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		// Resolve --addon-id or --addon from AddonsList, IDs being used as is
+		addonIDSelector, _ := cmd.Flags().GetString("addon")
+		if looksLikeID(addonIDSelector) {
+			addonID = addonIDSelector
+		} else if addonIDSelector != "" || addonID != "" && !looksLikeID(addonID) {
+			addonIDList, err := client.AddonsList(ctx, app)
+			if err == nil {
+				addonIDItems := make([]resolveItem, len(addonIDList))
+				for i, item := range addonIDList {
+					addonIDItems[i].ID = item.ID
+					addonIDItems[i].Names = []string{item.Name}
+					addonIDItems[i].CreatedAt = item.CreatedAt
+				}
+				if addonIDSelector != "" {
+					addonID, err = resolveID("addon", addonIDSelector, addonIDItems, false)
+				} else {
+					addonID, err = resolveName("addon", addonID, addonIDItems, false)
+				}
+			} else if addonIDSelector == "" {
+				// --addon-id goes to the API as given when it can't be listed
+				err = nil
+			}
+			if err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+		}
+
 		result, err := client.DatabaseEnableFeature(ctx, app, addonID, feature)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -113,56 +193,97 @@ var databasesDatabaseEnableFeatureCmd = &cobra.Command{
 
 func initdatabasesDatabaseEnableFeatureCmd() {
 
-	databasesDatabaseEnableFeatureCmd.Flags().StringP("app", "a", "", "app parameter")
+	databasesDatabaseEnableFeatureCmd.Flags().String("addon-id", "", "Addon ID, name or unique prefix")
 
-	databasesDatabaseEnableFeatureCmd.Flags().String("addon-i-d", "", "addonID parameter")
+	databasesDatabaseEnableFeatureCmd.Flags().String("addon", "", "Addon to use instead of --addon-id: latest, or its ID, name or unique prefix")
 
 	databasesDatabaseEnableFeatureCmd.Flags().String("feature", "", "feature parameter")
 
-	databasesDatabaseEnableFeatureCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
+	databasesDatabaseEnableFeatureCmd.MarkFlagsMutuallyExclusive("addon-id", "addon")
+
 }
 
 var databasesDatabaseDisableFeatureCmd = &cobra.Command{
 	Use:   "database-disable-feature",
 	Short: "Databases database-disable-feature",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["databases database-disable-feature"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		client, err := newClient(ctx)
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		app, _ := cmd.Flags().GetString("app")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		addonID, _ := cmd.Flags().GetString("addon-i-d")
+		addonID, _ := cmd.Flags().GetString("addon-id")
 
 		feature, _ := cmd.Flags().GetString("feature")
 
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		// Resolve --addon-id or --addon from AddonsList, IDs being used as is
+		addonIDSelector, _ := cmd.Flags().GetString("addon")
+		if looksLikeID(addonIDSelector) {
+			addonID = addonIDSelector
+		} else if addonIDSelector != "" || addonID != "" && !looksLikeID(addonID) {
+			addonIDList, err := client.AddonsList(ctx, app)
+			if err == nil {
+				addonIDItems := make([]resolveItem, len(addonIDList))
+				for i, item := range addonIDList {
+					addonIDItems[i].ID = item.ID
+					addonIDItems[i].Names = []string{item.Name}
+					addonIDItems[i].CreatedAt = item.CreatedAt
+				}
+				if addonIDSelector != "" {
+					addonID, err = resolveID("addon", addonIDSelector, addonIDItems, false)
+				} else {
+					addonID, err = resolveName("addon", addonID, addonIDItems, false)
+				}
+			} else if addonIDSelector == "" {
+				// --addon-id goes to the API as given when it can't be listed
+				err = nil
+			}
+			if err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+		}
+
 		result, err := client.DatabaseDisableFeature(ctx, app, addonID, feature)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -170,46 +291,87 @@ var databasesDatabaseDisableFeatureCmd = &cobra.Command{
 
 func initdatabasesDatabaseDisableFeatureCmd() {
 
-	databasesDatabaseDisableFeatureCmd.Flags().StringP("app", "a", "", "app parameter")
+	databasesDatabaseDisableFeatureCmd.Flags().String("addon-id", "", "Addon ID, name or unique prefix")
 
-	databasesDatabaseDisableFeatureCmd.Flags().String("addon-i-d", "", "addonID parameter")
+	databasesDatabaseDisableFeatureCmd.Flags().String("addon", "", "Addon to use instead of --addon-id: latest, or its ID, name or unique prefix")
 
 	databasesDatabaseDisableFeatureCmd.Flags().String("feature", "", "feature parameter")
 
-	databasesDatabaseDisableFeatureCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
+	databasesDatabaseDisableFeatureCmd.MarkFlagsMutuallyExclusive("addon-id", "addon")
+
 }
 
 var databasesDatabaseUpdatePeriodicBackupsConfigCmd = &cobra.Command{
 	Use:   "database-update-periodic-backups-config",
 	Short: "Databases database-update-periodic-backups-config",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["databases database-update-periodic-backups-config"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		client, err := newClient(ctx)
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		app, _ := cmd.Flags().GetString("app")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		addonID, _ := cmd.Flags().GetString("addon-i-d")
+		addonID, _ := cmd.Flags().GetString("addon-id")
 
 		scheduledAtFlag, _ := cmd.Flags().GetInt("scheduled-at")
 
 		enabledFlag, _ := cmd.Flags().GetBool("enabled")
 
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		// Resolve --addon-id or --addon from AddonsList, IDs being used as is
+		addonIDSelector, _ := cmd.Flags().GetString("addon")
+		if looksLikeID(addonIDSelector) {
+			addonID = addonIDSelector
+		} else if addonIDSelector != "" || addonID != "" && !looksLikeID(addonID) {
+			addonIDList, err := client.AddonsList(ctx, app)
+			if err == nil {
+				addonIDItems := make([]resolveItem, len(addonIDList))
+				for i, item := range addonIDList {
+					addonIDItems[i].ID = item.ID
+					addonIDItems[i].Names = []string{item.Name}
+					addonIDItems[i].CreatedAt = item.CreatedAt
+				}
+				if addonIDSelector != "" {
+					addonID, err = resolveID("addon", addonIDSelector, addonIDItems, false)
+				} else {
+					addonID, err = resolveName("addon", addonID, addonIDItems, false)
+				}
+			} else if addonIDSelector == "" {
+				// --addon-id goes to the API as given when it can't be listed
+				err = nil
+			}
+			if err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+		}
+
 		params := scalingo.DatabaseUpdatePeriodicBackupsConfigParams{
 			ScheduledAt: &scheduledAtFlag,
 			Enabled:     &enabledFlag,
@@ -217,16 +379,16 @@ var databasesDatabaseUpdatePeriodicBackupsConfigCmd = &cobra.Command{
 
 		result, err := client.DatabaseUpdatePeriodicBackupsConfig(ctx, app, addonID, params)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -234,65 +396,106 @@ var databasesDatabaseUpdatePeriodicBackupsConfigCmd = &cobra.Command{
 
 func initdatabasesDatabaseUpdatePeriodicBackupsConfigCmd() {
 
-	databasesDatabaseUpdatePeriodicBackupsConfigCmd.Flags().StringP("app", "a", "", "app parameter")
+	databasesDatabaseUpdatePeriodicBackupsConfigCmd.Flags().String("addon-id", "", "Addon ID, name or unique prefix")
 
-	databasesDatabaseUpdatePeriodicBackupsConfigCmd.Flags().String("addon-i-d", "", "addonID parameter")
+	databasesDatabaseUpdatePeriodicBackupsConfigCmd.Flags().String("addon", "", "Addon to use instead of --addon-id: latest, or its ID, name or unique prefix")
 
 	databasesDatabaseUpdatePeriodicBackupsConfigCmd.Flags().Int("scheduled-at", 0, "ScheduledAt field")
 
 	databasesDatabaseUpdatePeriodicBackupsConfigCmd.Flags().Bool("enabled", false, "Enabled field")
 
-	databasesDatabaseUpdatePeriodicBackupsConfigCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
+	databasesDatabaseUpdatePeriodicBackupsConfigCmd.MarkFlagsMutuallyExclusive("addon-id", "addon")
+
 }
 
 var databasesDatabaseUpdateMaintenanceWindowCmd = &cobra.Command{
 	Use:   "database-update-maintenance-window",
 	Short: "Databases database-update-maintenance-window",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["databases database-update-maintenance-window"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		app, _ := cmd.Flags().GetString("app")
+		addonID, _ := cmd.Flags().GetString("addon-id")
 
-		addonID, _ := cmd.Flags().GetString("addon-i-d")
+		weekdayUtcFlag, _ := cmd.Flags().GetInt("weekday-utc")
 
-		weekdayUTCFlag, _ := cmd.Flags().GetInt("weekday-u-t-c")
+		startingHourUtcFlag, _ := cmd.Flags().GetInt("starting-hour-utc")
 
-		startingHourUTCFlag, _ := cmd.Flags().GetInt("starting-hour-u-t-c")
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		// Resolve --addon-id or --addon from AddonsList, IDs being used as is
+		addonIDSelector, _ := cmd.Flags().GetString("addon")
+		if looksLikeID(addonIDSelector) {
+			addonID = addonIDSelector
+		} else if addonIDSelector != "" || addonID != "" && !looksLikeID(addonID) {
+			addonIDList, err := client.AddonsList(ctx, app)
+			if err == nil {
+				addonIDItems := make([]resolveItem, len(addonIDList))
+				for i, item := range addonIDList {
+					addonIDItems[i].ID = item.ID
+					addonIDItems[i].Names = []string{item.Name}
+					addonIDItems[i].CreatedAt = item.CreatedAt
+				}
+				if addonIDSelector != "" {
+					addonID, err = resolveID("addon", addonIDSelector, addonIDItems, false)
+				} else {
+					addonID, err = resolveName("addon", addonID, addonIDItems, false)
+				}
+			} else if addonIDSelector == "" {
+				// --addon-id goes to the API as given when it can't be listed
+				err = nil
+			}
+			if err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+		}
 
 		params := scalingo.MaintenanceWindowParams{
-			WeekdayUTC:      &weekdayUTCFlag,
-			StartingHourUTC: &startingHourUTCFlag,
+			WeekdayUTC:      &weekdayUtcFlag,
+			StartingHourUTC: &startingHourUtcFlag,
 		}
 
 		result, err := client.DatabaseUpdateMaintenanceWindow(ctx, app, addonID, params)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -300,66 +503,92 @@ var databasesDatabaseUpdateMaintenanceWindowCmd = &cobra.Command{
 
 func initdatabasesDatabaseUpdateMaintenanceWindowCmd() {
 
-	databasesDatabaseUpdateMaintenanceWindowCmd.Flags().StringP("app", "a", "", "app parameter")
+	databasesDatabaseUpdateMaintenanceWindowCmd.Flags().String("addon-id", "", "Addon ID, name or unique prefix")
 
-	databasesDatabaseUpdateMaintenanceWindowCmd.Flags().String("addon-i-d", "", "addonID parameter")
+	databasesDatabaseUpdateMaintenanceWindowCmd.Flags().String("addon", "", "Addon to use instead of --addon-id: latest, or its ID, name or unique prefix")
 
-	databasesDatabaseUpdateMaintenanceWindowCmd.Flags().Int("weekday-u-t-c", 0, "WeekdayUTC field")
+	databasesDatabaseUpdateMaintenanceWindowCmd.Flags().Int("weekday-utc", 0, "WeekdayUTC field")
 
-	databasesDatabaseUpdateMaintenanceWindowCmd.Flags().Int("starting-hour-u-t-c", 0, "StartingHourUTC field")
+	databasesDatabaseUpdateMaintenanceWindowCmd.Flags().Int("starting-hour-utc", 0, "StartingHourUTC field")
+
+	databasesDatabaseUpdateMaintenanceWindowCmd.MarkFlagsMutuallyExclusive("addon-id", "addon")
 
-	databasesDatabaseUpdateMaintenanceWindowCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var databasesDatabaseListMaintenanceCmd = &cobra.Command{
 	Use:   "database-list-maintenance",
 	Short: "Databases database-list-maintenance",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
+
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["databases database-list-maintenance"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		authToken, err := config.C.LoadAuth()
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		app, _ := cmd.Flags().GetString("app")
+		addonID, _ := cmd.Flags().GetString("addon-id")
 
-		addonID, _ := cmd.Flags().GetString("addon-i-d")
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		var allResults []*scalingo.Maintenance
-		page := 1
-		for {
-			results, meta, err := client.DatabaseListMaintenance(ctx, app, addonID, scalingo.PaginationOpts{Page: page, PerPage: 100})
+		// Resolve --addon-id or --addon from AddonsList, IDs being used as is
+		addonIDSelector, _ := cmd.Flags().GetString("addon")
+		if looksLikeID(addonIDSelector) {
+			addonID = addonIDSelector
+		} else if addonIDSelector != "" || addonID != "" && !looksLikeID(addonID) {
+			addonIDList, err := client.AddonsList(ctx, app)
+			if err == nil {
+				addonIDItems := make([]resolveItem, len(addonIDList))
+				for i, item := range addonIDList {
+					addonIDItems[i].ID = item.ID
+					addonIDItems[i].Names = []string{item.Name}
+					addonIDItems[i].CreatedAt = item.CreatedAt
+				}
+				if addonIDSelector != "" {
+					addonID, err = resolveID("addon", addonIDSelector, addonIDItems, false)
+				} else {
+					addonID, err = resolveName("addon", addonID, addonIDItems, false)
+				}
+			} else if addonIDSelector == "" {
+				// --addon-id goes to the API as given when it can't be listed
+				err = nil
+			}
 			if err != nil {
-				fmt.Println(render.RenderError(err))
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 				return err
 			}
-			allResults = append(allResults, results...)
-			if meta.NextPage == 0 {
-				break
-			}
-			page = meta.NextPage
 		}
-		result := allResults
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		err = paginate(cmd, hooks, outputFormat, func(opts scalingo.PaginationOpts) ([]*scalingo.Maintenance, scalingo.PaginationMeta, error) {
+			return client.DatabaseListMaintenance(ctx, app, addonID, opts)
+		})
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
 
 		return nil
 	},
@@ -367,54 +596,99 @@ var databasesDatabaseListMaintenanceCmd = &cobra.Command{
 
 func initdatabasesDatabaseListMaintenanceCmd() {
 
-	databasesDatabaseListMaintenanceCmd.Flags().StringP("app", "a", "", "app parameter")
+	databasesDatabaseListMaintenanceCmd.Flags().String("addon-id", "", "Addon ID, name or unique prefix")
+
+	databasesDatabaseListMaintenanceCmd.Flags().String("addon", "", "Addon to use instead of --addon-id: latest, or its ID, name or unique prefix")
+
+	addPaginationFlags(databasesDatabaseListMaintenanceCmd)
 
-	databasesDatabaseListMaintenanceCmd.Flags().String("addon-i-d", "", "addonID parameter")
+	addListFlags(databasesDatabaseListMaintenanceCmd)
+
+	databasesDatabaseListMaintenanceCmd.MarkFlagsMutuallyExclusive("addon-id", "addon")
 
-	databasesDatabaseListMaintenanceCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var databasesDatabaseShowMaintenanceCmd = &cobra.Command{
 	Use:   "database-show-maintenance",
 	Short: "Databases database-show-maintenance",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["databases database-show-maintenance"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		addonID, _ := cmd.Flags().GetString("addon-id")
 
-		app, _ := cmd.Flags().GetString("app")
+		maintenanceID, _ := cmd.Flags().GetString("maintenance-id")
 
-		addonID, _ := cmd.Flags().GetString("addon-i-d")
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		maintenanceID, _ := cmd.Flags().GetString("maintenance-i-d")
+		// Resolve --addon-id or --addon from AddonsList, IDs being used as is
+		addonIDSelector, _ := cmd.Flags().GetString("addon")
+		if looksLikeID(addonIDSelector) {
+			addonID = addonIDSelector
+		} else if addonIDSelector != "" || addonID != "" && !looksLikeID(addonID) {
+			addonIDList, err := client.AddonsList(ctx, app)
+			if err == nil {
+				addonIDItems := make([]resolveItem, len(addonIDList))
+				for i, item := range addonIDList {
+					addonIDItems[i].ID = item.ID
+					addonIDItems[i].Names = []string{item.Name}
+					addonIDItems[i].CreatedAt = item.CreatedAt
+				}
+				if addonIDSelector != "" {
+					addonID, err = resolveID("addon", addonIDSelector, addonIDItems, false)
+				} else {
+					addonID, err = resolveName("addon", addonID, addonIDItems, false)
+				}
+			} else if addonIDSelector == "" {
+				// --addon-id goes to the API as given when it can't be listed
+				err = nil
+			}
+			if err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+		}
 
 		result, err := client.DatabaseShowMaintenance(ctx, app, addonID, maintenanceID)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -422,13 +696,14 @@ var databasesDatabaseShowMaintenanceCmd = &cobra.Command{
 
 func initdatabasesDatabaseShowMaintenanceCmd() {
 
-	databasesDatabaseShowMaintenanceCmd.Flags().StringP("app", "a", "", "app parameter")
+	databasesDatabaseShowMaintenanceCmd.Flags().String("addon-id", "", "Addon ID, name or unique prefix")
+
+	databasesDatabaseShowMaintenanceCmd.Flags().String("addon", "", "Addon to use instead of --addon-id: latest, or its ID, name or unique prefix")
 
-	databasesDatabaseShowMaintenanceCmd.Flags().String("addon-i-d", "", "addonID parameter")
+	databasesDatabaseShowMaintenanceCmd.Flags().String("maintenance-id", "", "maintenanceID parameter")
 
-	databasesDatabaseShowMaintenanceCmd.Flags().String("maintenance-i-d", "", "maintenanceID parameter")
+	databasesDatabaseShowMaintenanceCmd.MarkFlagsMutuallyExclusive("addon-id", "addon")
 
-	databasesDatabaseShowMaintenanceCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 // RegisterDatabasesServiceCommands registers all generated commands with the parent
@@ -2,7 +2,6 @@
 package commands
 
 import (
-	"context"
 	"fmt"
 
 	scalingo "github.com/Scalingo/go-scalingo/v8"
@@ -16,39 +15,59 @@ var deploymentsDeploymentListCmd = &cobra.Command{
 	Use:   "deployment-list",
 	Short: "Deployments deployment-list",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["deployments deployment-list"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		app, _ := cmd.Flags().GetString("app")
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		result, err := client.DeploymentList(ctx, app)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		if browsing(cmd) {
+			if err := browse(cmd, hooks, result); err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+			return nil
+		}
+
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -56,58 +75,54 @@ var deploymentsDeploymentListCmd = &cobra.Command{
 
 func initdeploymentsDeploymentListCmd() {
 
-	deploymentsDeploymentListCmd.Flags().StringP("app", "a", "", "app parameter")
+	addListFlags(deploymentsDeploymentListCmd)
 
-	deploymentsDeploymentListCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var deploymentsDeploymentListWithPaginationCmd = &cobra.Command{
 	Use:   "deployment-list-with-pagination",
 	Short: "Deployments deployment-list-with-pagination",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["deployments deployment-list-with-pagination"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		client, err := newClient(ctx)
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		app, _ := cmd.Flags().GetString("app")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		var allResults []*scalingo.Deployment
-		page := 1
-		for {
-			results, meta, err := client.DeploymentListWithPagination(ctx, app, scalingo.PaginationOpts{Page: page, PerPage: 100})
-			if err != nil {
-				fmt.Println(render.RenderError(err))
-				return err
-			}
-			allResults = append(allResults, results...)
-			if meta.NextPage == 0 {
-				break
-			}
-			page = meta.NextPage
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
 		}
-		result := allResults
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		err = paginate(cmd, hooks, outputFormat, func(opts scalingo.PaginationOpts) ([]*scalingo.Deployment, scalingo.PaginationMeta, error) {
+			return client.DeploymentListWithPagination(ctx, app, opts)
+		})
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
 
 		return nil
 	},
@@ -115,50 +130,91 @@ var deploymentsDeploymentListWithPaginationCmd = &cobra.Command{
 
 func initdeploymentsDeploymentListWithPaginationCmd() {
 
-	deploymentsDeploymentListWithPaginationCmd.Flags().StringP("app", "a", "", "app parameter")
+	addPaginationFlags(deploymentsDeploymentListWithPaginationCmd)
+
+	addListFlags(deploymentsDeploymentListWithPaginationCmd)
 
-	deploymentsDeploymentListWithPaginationCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var deploymentsDeploymentCmd = &cobra.Command{
 	Use:   "deployment",
 	Short: "Deployments deployment",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["deployments deployment"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		client, err := newClient(ctx)
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		app, _ := cmd.Flags().GetString("app")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		deploy, _ := cmd.Flags().GetString("deploy")
 
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		// Resolve --deploy or --deployment from DeploymentList, IDs being used as is
+		deploySelector, _ := cmd.Flags().GetString("deployment")
+		if looksLikeID(deploySelector) {
+			deploy = deploySelector
+		} else if deploySelector != "" || deploy != "" && !looksLikeID(deploy) {
+			deployList, err := client.DeploymentList(ctx, app)
+			if err == nil {
+				deployItems := make([]resolveItem, len(deployList))
+				for i, item := range deployList {
+					deployItems[i].ID = item.ID
+					deployItems[i].Names = []string{item.Name}
+					deployItems[i].CreatedAt = item.CreatedAt
+				}
+				if deploySelector != "" {
+					deploy, err = resolveID("deployment", deploySelector, deployItems, false)
+				} else {
+					deploy, err = resolveName("deployment", deploy, deployItems, false)
+				}
+			} else if deploySelector == "" {
+				// --deploy goes to the API as given when it can't be listed
+				err = nil
+			}
+			if err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+		}
+
 		result, err := client.Deployment(ctx, app, deploy)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -166,50 +222,54 @@ var deploymentsDeploymentCmd = &cobra.Command{
 
 func initdeploymentsDeploymentCmd() {
 
-	deploymentsDeploymentCmd.Flags().StringP("app", "a", "", "app parameter")
+	deploymentsDeploymentCmd.Flags().String("deploy", "", "Deployment ID, name or unique prefix")
 
-	deploymentsDeploymentCmd.Flags().String("deploy", "", "deploy parameter")
+	deploymentsDeploymentCmd.Flags().String("deployment", "", "Deployment to use instead of --deploy: latest, or its ID, name or unique prefix")
+
+	deploymentsDeploymentCmd.MarkFlagsMutuallyExclusive("deploy", "deployment")
 
-	deploymentsDeploymentCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var deploymentsDeploymentLogsCmd = &cobra.Command{
 	Use:   "deployment-logs",
 	Short: "Deployments deployment-logs",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["deployments deployment-logs"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		client, err := newClient(ctx)
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		deployURL, _ := cmd.Flags().GetString("deploy-u-r-l")
+		deployURL, _ := cmd.Flags().GetString("deploy-url")
 
-		result, err := client.DeploymentLogs(ctx, deployURL)
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		err = stream(cmd, outputFormat, func() (any, error) {
+			return client.DeploymentLogs(ctx, deployURL)
+		})
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
 
 		return nil
 	},
@@ -217,48 +277,52 @@ var deploymentsDeploymentLogsCmd = &cobra.Command{
 
 func initdeploymentsDeploymentLogsCmd() {
 
-	deploymentsDeploymentLogsCmd.Flags().String("deploy-u-r-l", "", "deployURL parameter")
+	deploymentsDeploymentLogsCmd.Flags().String("deploy-url", "", "deployURL parameter")
+
+	addStreamFlags(deploymentsDeploymentLogsCmd)
 
-	deploymentsDeploymentLogsCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var deploymentsDeploymentStreamCmd = &cobra.Command{
 	Use:   "deployment-stream",
 	Short: "Deployments deployment-stream",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["deployments deployment-stream"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		client, err := newClient(ctx)
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		deployURL, _ := cmd.Flags().GetString("deploy-u-r-l")
+		deployURL, _ := cmd.Flags().GetString("deploy-url")
 
-		result, err := client.DeploymentStream(ctx, deployURL)
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		err = stream(cmd, outputFormat, func() (any, error) {
+			return client.DeploymentStream(ctx, deployURL)
+		})
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
 
 		return nil
 	},
@@ -266,39 +330,52 @@ var deploymentsDeploymentStreamCmd = &cobra.Command{
 
 func initdeploymentsDeploymentStreamCmd() {
 
-	deploymentsDeploymentStreamCmd.Flags().String("deploy-u-r-l", "", "deployURL parameter")
+	deploymentsDeploymentStreamCmd.Flags().String("deploy-url", "", "deployURL parameter")
+
+	addStreamFlags(deploymentsDeploymentStreamCmd)
 
-	deploymentsDeploymentStreamCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var deploymentsCreateCmd = &cobra.Command{
 	Use:   "create",
 	Short: "Deployments create",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["deployments create"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		client, err := newClient(ctx)
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		app, _ := cmd.Flags().GetString("app")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		gitRefFlag, _ := cmd.Flags().GetString("git-ref")
 
-		sourceURLFlag, _ := cmd.Flags().GetString("source---a-c-r1--")
+		sourceURLFlag, _ := cmd.Flags().GetString("source-url")
+
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		params := &scalingo.DeploymentsCreateParams{
 			GitRef:    &gitRefFlag,
@@ -307,16 +384,16 @@ var deploymentsCreateCmd = &cobra.Command{
 
 		result, err := client.DeploymentsCreate(ctx, app, params)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -324,13 +401,10 @@ var deploymentsCreateCmd = &cobra.Command{
 
 func initdeploymentsCreateCmd() {
 
-	deploymentsCreateCmd.Flags().StringP("app", "a", "", "app parameter")
-
 	deploymentsCreateCmd.Flags().String("git-ref", "", "GitRef field")
 
-	deploymentsCreateCmd.Flags().String("source---a-c-r1--", "", "SourceURL field")
+	deploymentsCreateCmd.Flags().String("source-url", "", "SourceURL field")
 
-	deploymentsCreateCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 // RegisterDeploymentsServiceCommands registers all generated commands with the parent
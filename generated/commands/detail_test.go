@@ -0,0 +1,56 @@
+package commands
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+
+	"generative-cli/config"
+)
+
+type detailOwner struct {
+	Username string `json:"username"`
+}
+
+// detailApp is an app with a nested owner, shown by useDetailApp
+type detailApp struct {
+	Name  string      `json:"name"`
+	Owner detailOwner `json:"owner"`
+}
+
+// useDetailApp makes apps show render a detailApp
+func useDetailApp(t *testing.T) {
+	t.Helper()
+	useFakeClient(t)
+	useHooks(t, "apps show", Hooks{
+		AfterCall: func(cmd *cobra.Command, result any) (any, error) {
+			return &detailApp{Name: "web", Owner: detailOwner{Username: "alice"}}, nil
+		},
+	})
+}
+
+func TestDetailDepthFlag(t *testing.T) {
+	useDetailApp(t)
+	expanded := runCommand(t, "apps", "show")
+	config.C.DepthFlag = 0
+	inline := runCommand(t, "apps", "show")
+
+	if !strings.Contains(expanded, "Username: alice") {
+		t.Errorf("expected the owner expanded, got:\n%s", expanded)
+	}
+	if !strings.Contains(inline, "<detailOwner>") {
+		t.Errorf("expected the owner inline with --depth 0, got:\n%s", inline)
+	}
+}
+
+func TestDetailFormat(t *testing.T) {
+	useDetailApp(t)
+	table := runCommand(t, "apps", "show")
+	config.C.OutputFlag = "detail"
+	detail := runCommand(t, "apps", "show")
+
+	if detail != table {
+		t.Errorf("expected -o detail to render the detail view, got:\n%s\nwant:\n%s", detail, table)
+	}
+}
@@ -2,7 +2,6 @@
 package commands
 
 import (
-	"context"
 	"fmt"
 
 	scalingo "github.com/Scalingo/go-scalingo/v8"
@@ -16,39 +15,59 @@ var domainsListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "Domains list",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["domains list"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		app, _ := cmd.Flags().GetString("app")
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		result, err := client.DomainsList(ctx, app)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		if browsing(cmd) {
+			if err := browse(cmd, hooks, result); err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+			return nil
+		}
+
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -56,66 +75,77 @@ var domainsListCmd = &cobra.Command{
 
 func initdomainsListCmd() {
 
-	domainsListCmd.Flags().StringP("app", "a", "", "app parameter")
+	addListFlags(domainsListCmd)
 
-	domainsListCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var domainsAddCmd = &cobra.Command{
 	Use:   "add",
 	Short: "Domains add",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["domains add"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		client, err := newClient(ctx)
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		app, _ := cmd.Flags().GetString("app")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		nameFlag, _ := cmd.Flags().GetString("name")
 
 		canonicalFlag, _ := cmd.Flags().GetBool("canonical")
 
-		tLSCertFlag, _ := cmd.Flags().GetString("---a-c-r7---cert")
+		tlsCertFlag, _ := cmd.Flags().GetString("tls-cert")
 
-		tLSKeyFlag, _ := cmd.Flags().GetString("---a-c-r7---key")
+		tlsKeyFlag, _ := cmd.Flags().GetString("tls-key")
 
 		letsEncryptEnabledFlag, _ := cmd.Flags().GetBool("lets-encrypt-enabled")
 
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
 		d := scalingo.DomainsAddParams{
 			Name:               nameFlag,
 			Canonical:          &canonicalFlag,
-			TLSCert:            &tLSCertFlag,
-			TLSKey:             &tLSKeyFlag,
+			TLSCert:            &tlsCertFlag,
+			TLSKey:             &tlsKeyFlag,
 			LetsEncryptEnabled: &letsEncryptEnabledFlag,
 		}
 
 		result, err := client.DomainsAdd(ctx, app, d)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -123,75 +153,112 @@ var domainsAddCmd = &cobra.Command{
 
 func initdomainsAddCmd() {
 
-	domainsAddCmd.Flags().StringP("app", "a", "", "app parameter")
-
 	domainsAddCmd.Flags().String("name", "", "Name field")
 
 	domainsAddCmd.Flags().Bool("canonical", false, "Canonical field")
 
-	domainsAddCmd.Flags().String("---a-c-r7---cert", "", "TLSCert field")
+	domainsAddCmd.Flags().String("tls-cert", "", "TLSCert field")
 
-	domainsAddCmd.Flags().String("---a-c-r7---key", "", "TLSKey field")
+	domainsAddCmd.Flags().String("tls-key", "", "TLSKey field")
 
 	domainsAddCmd.Flags().Bool("lets-encrypt-enabled", false, "LetsEncryptEnabled field")
 
-	domainsAddCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var domainsUpdateCmd = &cobra.Command{
 	Use:   "update",
 	Short: "Domains update",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["domains update"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		client, err := newClient(ctx)
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		app, _ := cmd.Flags().GetString("app")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		id, _ := cmd.Flags().GetString("id")
 
 		canonicalFlag, _ := cmd.Flags().GetBool("canonical")
 
-		tLSCertFlag, _ := cmd.Flags().GetString("---a-c-r7---cert")
+		tlsCertFlag, _ := cmd.Flags().GetString("tls-cert")
 
-		tLSKeyFlag, _ := cmd.Flags().GetString("---a-c-r7---key")
+		tlsKeyFlag, _ := cmd.Flags().GetString("tls-key")
 
 		letsEncryptEnabledFlag, _ := cmd.Flags().GetBool("lets-encrypt-enabled")
 
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		// Resolve --id or --domain from DomainsList, IDs being used as is
+		idSelector, _ := cmd.Flags().GetString("domain")
+		if looksLikeID(idSelector) {
+			id = idSelector
+		} else if idSelector != "" || id != "" && !looksLikeID(id) {
+			idList, err := client.DomainsList(ctx, app)
+			if err == nil {
+				idItems := make([]resolveItem, len(idList))
+				for i, item := range idList {
+					idItems[i].ID = item.ID
+					idItems[i].Names = []string{item.Name}
+					idItems[i].CreatedAt = item.CreatedAt
+				}
+				if idSelector != "" {
+					id, err = resolveID("domain", idSelector, idItems, false)
+				} else {
+					id, err = resolveName("domain", id, idItems, false)
+				}
+			} else if idSelector == "" {
+				// --id goes to the API as given when it can't be listed
+				err = nil
+			}
+			if err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+		}
+
 		d := scalingo.DomainsUpdateParams{
 			Canonical:          &canonicalFlag,
-			TLSCert:            &tLSCertFlag,
-			TLSKey:             &tLSKeyFlag,
+			TLSCert:            &tlsCertFlag,
+			TLSKey:             &tlsKeyFlag,
 			LetsEncryptEnabled: &letsEncryptEnabledFlag,
 		}
 
 		result, err := client.DomainsUpdate(ctx, app, id, d)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -199,51 +266,88 @@ var domainsUpdateCmd = &cobra.Command{
 
 func initdomainsUpdateCmd() {
 
-	domainsUpdateCmd.Flags().StringP("app", "a", "", "app parameter")
+	domainsUpdateCmd.Flags().StringP("id", "i", "", "Domain ID, name or unique prefix")
 
-	domainsUpdateCmd.Flags().StringP("id", "i", "", "id parameter")
+	domainsUpdateCmd.Flags().String("domain", "", "Domain to use instead of --id: latest, or its ID, name or unique prefix")
 
 	domainsUpdateCmd.Flags().Bool("canonical", false, "Canonical field")
 
-	domainsUpdateCmd.Flags().String("---a-c-r7---cert", "", "TLSCert field")
+	domainsUpdateCmd.Flags().String("tls-cert", "", "TLSCert field")
 
-	domainsUpdateCmd.Flags().String("---a-c-r7---key", "", "TLSKey field")
+	domainsUpdateCmd.Flags().String("tls-key", "", "TLSKey field")
 
 	domainsUpdateCmd.Flags().Bool("lets-encrypt-enabled", false, "LetsEncryptEnabled field")
 
-	domainsUpdateCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
+	domainsUpdateCmd.MarkFlagsMutuallyExclusive("id", "domain")
+
 }
 
 var domainsRemoveCmd = &cobra.Command{
 	Use:   "remove",
 	Short: "Domains remove",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["domains remove"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		app, _ := cmd.Flags().GetString("app")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		id, _ := cmd.Flags().GetString("id")
 
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		// Resolve --id or --domain from DomainsList, IDs being used as is
+		idSelector, _ := cmd.Flags().GetString("domain")
+		if looksLikeID(idSelector) {
+			id = idSelector
+		} else if idSelector != "" || id != "" && !looksLikeID(id) {
+			idList, err := client.DomainsList(ctx, app)
+			if err == nil {
+				idItems := make([]resolveItem, len(idList))
+				for i, item := range idList {
+					idItems[i].ID = item.ID
+					idItems[i].Names = []string{item.Name}
+					idItems[i].CreatedAt = item.CreatedAt
+				}
+				if idSelector != "" {
+					id, err = resolveID("domain", idSelector, idItems, true)
+				} else {
+					id, err = resolveName("domain", id, idItems, true)
+				}
+			} else if idSelector == "" {
+				// --id goes to the API as given when it can't be listed
+				err = nil
+			}
+			if err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+		}
+
 		if err := client.DomainsRemove(ctx, app, id); err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(render.RenderSuccess("remove completed successfully"))
+		fmt.Fprintln(cmd.OutOrStdout(), render.RenderSuccess("remove completed successfully"))
 
 		return nil
 	},
@@ -251,52 +355,93 @@ var domainsRemoveCmd = &cobra.Command{
 
 func initdomainsRemoveCmd() {
 
-	domainsRemoveCmd.Flags().StringP("app", "a", "", "app parameter")
+	domainsRemoveCmd.Flags().StringP("id", "i", "", "Domain ID or name")
+
+	domainsRemoveCmd.Flags().String("domain", "", "Domain to use instead of --id: latest, or its ID or name")
 
-	domainsRemoveCmd.Flags().StringP("id", "i", "", "id parameter")
+	domainsRemoveCmd.MarkFlagsMutuallyExclusive("id", "domain")
 
-	domainsRemoveCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var domainsDomainSetCanonicalCmd = &cobra.Command{
 	Use:   "domain-set-canonical",
 	Short: "Domains domain-set-canonical",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["domains domain-set-canonical"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		client, err := newClient(ctx)
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		app, _ := cmd.Flags().GetString("app")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		id, _ := cmd.Flags().GetString("id")
 
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		// Resolve --id or --domain from DomainsList, IDs being used as is
+		idSelector, _ := cmd.Flags().GetString("domain")
+		if looksLikeID(idSelector) {
+			id = idSelector
+		} else if idSelector != "" || id != "" && !looksLikeID(id) {
+			idList, err := client.DomainsList(ctx, app)
+			if err == nil {
+				idItems := make([]resolveItem, len(idList))
+				for i, item := range idList {
+					idItems[i].ID = item.ID
+					idItems[i].Names = []string{item.Name}
+					idItems[i].CreatedAt = item.CreatedAt
+				}
+				if idSelector != "" {
+					id, err = resolveID("domain", idSelector, idItems, false)
+				} else {
+					id, err = resolveName("domain", id, idItems, false)
+				}
+			} else if idSelector == "" {
+				// --id goes to the API as given when it can't be listed
+				err = nil
+			}
+			if err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+		}
+
 		result, err := client.DomainSetCanonical(ctx, app, id)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -304,50 +449,63 @@ var domainsDomainSetCanonicalCmd = &cobra.Command{
 
 func initdomainsDomainSetCanonicalCmd() {
 
-	domainsDomainSetCanonicalCmd.Flags().StringP("app", "a", "", "app parameter")
+	domainsDomainSetCanonicalCmd.Flags().StringP("id", "i", "", "Domain ID, name or unique prefix")
+
+	domainsDomainSetCanonicalCmd.Flags().String("domain", "", "Domain to use instead of --id: latest, or its ID, name or unique prefix")
 
-	domainsDomainSetCanonicalCmd.Flags().StringP("id", "i", "", "id parameter")
+	domainsDomainSetCanonicalCmd.MarkFlagsMutuallyExclusive("id", "domain")
 
-	domainsDomainSetCanonicalCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var domainsDomainUnsetCanonicalCmd = &cobra.Command{
 	Use:   "domain-unset-canonical",
 	Short: "Domains domain-unset-canonical",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["domains domain-unset-canonical"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		app, _ := cmd.Flags().GetString("app")
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		result, err := client.DomainUnsetCanonical(ctx, app)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -355,35 +513,39 @@ var domainsDomainUnsetCanonicalCmd = &cobra.Command{
 
 func initdomainsDomainUnsetCanonicalCmd() {
 
-	domainsDomainUnsetCanonicalCmd.Flags().StringP("app", "a", "", "app parameter")
-
-	domainsDomainUnsetCanonicalCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var domainsDomainSetCertificateCmd = &cobra.Command{
 	Use:   "domain-set-certificate",
 	Short: "Domains domain-set-certificate",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["domains domain-set-certificate"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		client, err := newClient(ctx)
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		app, _ := cmd.Flags().GetString("app")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		id, _ := cmd.Flags().GetString("id")
 
@@ -391,18 +553,51 @@ var domainsDomainSetCertificateCmd = &cobra.Command{
 
 		tlsKey, _ := cmd.Flags().GetString("tls-key")
 
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		// Resolve --id or --domain from DomainsList, IDs being used as is
+		idSelector, _ := cmd.Flags().GetString("domain")
+		if looksLikeID(idSelector) {
+			id = idSelector
+		} else if idSelector != "" || id != "" && !looksLikeID(id) {
+			idList, err := client.DomainsList(ctx, app)
+			if err == nil {
+				idItems := make([]resolveItem, len(idList))
+				for i, item := range idList {
+					idItems[i].ID = item.ID
+					idItems[i].Names = []string{item.Name}
+					idItems[i].CreatedAt = item.CreatedAt
+				}
+				if idSelector != "" {
+					id, err = resolveID("domain", idSelector, idItems, false)
+				} else {
+					id, err = resolveName("domain", id, idItems, false)
+				}
+			} else if idSelector == "" {
+				// --id goes to the API as given when it can't be listed
+				err = nil
+			}
+			if err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+		}
+
 		result, err := client.DomainSetCertificate(ctx, app, id, tlsCert, tlsKey)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -410,56 +605,97 @@ var domainsDomainSetCertificateCmd = &cobra.Command{
 
 func initdomainsDomainSetCertificateCmd() {
 
-	domainsDomainSetCertificateCmd.Flags().StringP("app", "a", "", "app parameter")
+	domainsDomainSetCertificateCmd.Flags().StringP("id", "i", "", "Domain ID, name or unique prefix")
 
-	domainsDomainSetCertificateCmd.Flags().StringP("id", "i", "", "id parameter")
+	domainsDomainSetCertificateCmd.Flags().String("domain", "", "Domain to use instead of --id: latest, or its ID, name or unique prefix")
 
 	domainsDomainSetCertificateCmd.Flags().String("tls-cert", "", "tlsCert parameter")
 
 	domainsDomainSetCertificateCmd.Flags().String("tls-key", "", "tlsKey parameter")
 
-	domainsDomainSetCertificateCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
+	domainsDomainSetCertificateCmd.MarkFlagsMutuallyExclusive("id", "domain")
+
 }
 
 var domainsDomainUnsetCertificateCmd = &cobra.Command{
 	Use:   "domain-unset-certificate",
 	Short: "Domains domain-unset-certificate",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["domains domain-unset-certificate"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		client, err := newClient(ctx)
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		app, _ := cmd.Flags().GetString("app")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		id, _ := cmd.Flags().GetString("id")
 
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		// Resolve --id or --domain from DomainsList, IDs being used as is
+		idSelector, _ := cmd.Flags().GetString("domain")
+		if looksLikeID(idSelector) {
+			id = idSelector
+		} else if idSelector != "" || id != "" && !looksLikeID(id) {
+			idList, err := client.DomainsList(ctx, app)
+			if err == nil {
+				idItems := make([]resolveItem, len(idList))
+				for i, item := range idList {
+					idItems[i].ID = item.ID
+					idItems[i].Names = []string{item.Name}
+					idItems[i].CreatedAt = item.CreatedAt
+				}
+				if idSelector != "" {
+					id, err = resolveID("domain", idSelector, idItems, false)
+				} else {
+					id, err = resolveName("domain", id, idItems, false)
+				}
+			} else if idSelector == "" {
+				// --id goes to the API as given when it can't be listed
+				err = nil
+			}
+			if err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+		}
+
 		result, err := client.DomainUnsetCertificate(ctx, app, id)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -467,11 +703,12 @@ var domainsDomainUnsetCertificateCmd = &cobra.Command{
 
 func initdomainsDomainUnsetCertificateCmd() {
 
-	domainsDomainUnsetCertificateCmd.Flags().StringP("app", "a", "", "app parameter")
+	domainsDomainUnsetCertificateCmd.Flags().StringP("id", "i", "", "Domain ID, name or unique prefix")
+
+	domainsDomainUnsetCertificateCmd.Flags().String("domain", "", "Domain to use instead of --id: latest, or its ID, name or unique prefix")
 
-	domainsDomainUnsetCertificateCmd.Flags().StringP("id", "i", "", "id parameter")
+	domainsDomainUnsetCertificateCmd.MarkFlagsMutuallyExclusive("id", "domain")
 
-	domainsDomainUnsetCertificateCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 // RegisterDomainsServiceCommands registers all generated commands with the parent
@@ -2,7 +2,6 @@
 package commands
 
 import (
-	"context"
 	"fmt"
 
 	scalingo "github.com/Scalingo/go-scalingo/v8"
@@ -16,37 +15,53 @@ var eventsEventTypesListCmd = &cobra.Command{
 	Use:   "event-types-list",
 	Short: "Events event-types-list",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["events event-types-list"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		result, err := client.EventTypesList(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		if browsing(cmd) {
+			if err := browse(cmd, hooks, result); err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+			return nil
+		}
+
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -54,44 +69,61 @@ var eventsEventTypesListCmd = &cobra.Command{
 
 func initeventsEventTypesListCmd() {
 
-	eventsEventTypesListCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
+	addListFlags(eventsEventTypesListCmd)
+
 }
 
 var eventsEventCategoriesListCmd = &cobra.Command{
 	Use:   "event-categories-list",
 	Short: "Events event-categories-list",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
+
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["events event-categories-list"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		authToken, err := config.C.LoadAuth()
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		result, err := client.EventCategoriesList(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		if browsing(cmd) {
+			if err := browse(cmd, hooks, result); err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+			return nil
+		}
+
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -99,56 +131,54 @@ var eventsEventCategoriesListCmd = &cobra.Command{
 
 func initeventsEventCategoriesListCmd() {
 
-	eventsEventCategoriesListCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
+	addListFlags(eventsEventCategoriesListCmd)
+
 }
 
 var eventsListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "Events list",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["events list"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		client, err := newClient(ctx)
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		app, _ := cmd.Flags().GetString("app")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		var allResults scalingo.Events
-		page := 1
-		for {
-			results, meta, err := client.EventsList(ctx, app, scalingo.PaginationOpts{Page: page, PerPage: 100})
-			if err != nil {
-				fmt.Println(render.RenderError(err))
-				return err
-			}
-			allResults = append(allResults, results...)
-			if meta.NextPage == 0 {
-				break
-			}
-			page = meta.NextPage
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
 		}
-		result := allResults
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		err = paginate(cmd, hooks, outputFormat, func(opts scalingo.PaginationOpts) (scalingo.Events, scalingo.PaginationMeta, error) {
+			return client.EventsList(ctx, app, opts)
+		})
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
 
 		return nil
 	},
@@ -156,56 +186,50 @@ var eventsListCmd = &cobra.Command{
 
 func initeventsListCmd() {
 
-	eventsListCmd.Flags().StringP("app", "a", "", "app parameter")
+	addPaginationFlags(eventsListCmd)
+
+	addListFlags(eventsListCmd)
 
-	eventsListCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var eventsUserEventsListCmd = &cobra.Command{
 	Use:   "user-events-list",
 	Short: "Events user-events-list",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["events user-events-list"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		client, err := newClient(ctx)
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		var allResults scalingo.Events
-		page := 1
-		for {
-			results, meta, err := client.UserEventsList(ctx, scalingo.PaginationOpts{Page: page, PerPage: 100})
-			if err != nil {
-				fmt.Println(render.RenderError(err))
-				return err
-			}
-			allResults = append(allResults, results...)
-			if meta.NextPage == 0 {
-				break
-			}
-			page = meta.NextPage
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
 		}
-		result := allResults
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		err = paginate(cmd, hooks, outputFormat, func(opts scalingo.PaginationOpts) (scalingo.Events, scalingo.PaginationMeta, error) {
+			return client.UserEventsList(ctx, opts)
+		})
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
 
 		return nil
 	},
@@ -213,7 +237,10 @@ var eventsUserEventsListCmd = &cobra.Command{
 
 func initeventsUserEventsListCmd() {
 
-	eventsUserEventsListCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
+	addPaginationFlags(eventsUserEventsListCmd)
+
+	addListFlags(eventsUserEventsListCmd)
+
 }
 
 // RegisterEventsServiceCommands registers all generated commands with the parent
@@ -0,0 +1,63 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	scalingo "github.com/Scalingo/go-scalingo/v8"
+
+	"generative-cli/config"
+	"generative-cli/render"
+)
+
+// eventsClient lists two pages of one event each
+type eventsClient struct {
+	*fakeClient
+}
+
+func (c eventsClient) EventsList(ctx context.Context, app string, opts scalingo.PaginationOpts) (scalingo.Events, scalingo.PaginationMeta, error) {
+	events := scalingo.Events{{
+		ID:        fmt.Sprintf("event-%d", opts.Page),
+		Name:      "deploy, \"web\"",
+		CreatedAt: time.Date(2026, 1, opts.Page, 0, 0, 0, 0, time.UTC),
+	}}
+	meta := scalingo.PaginationMeta{CurrentPage: opts.Page, TotalPages: 2}
+	if opts.Page < 2 {
+		meta.NextPage = opts.Page + 1
+	}
+	c.record("EventsList", []any{app, opts}, events, meta)
+	return events, meta, nil
+}
+
+// eventsClientFor returns a newClient listing events with fake
+func eventsClientFor(fake *fakeClient) func(ctx context.Context) (Client, error) {
+	return func(ctx context.Context) (Client, error) {
+		return eventsClient{fake}, nil
+	}
+}
+
+// runEvents runs events list --all in format
+func runEvents(t *testing.T, format render.OutputFormat) string {
+	t.Helper()
+	newClient = eventsClientFor(useFakeClient(t))
+	resetFlags(t, []string{"events", "list"}, "page", "per-page", "limit", "all")
+	config.C.OutputFlag = string(format)
+	return runCommand(t, "events", "list", "--all")
+}
+
+func TestFormatUnknown(t *testing.T) {
+	fake := useFakeClient(t)
+	config.C.OutputFlag = "xml"
+
+	testRoot.SetArgs([]string{"apps", "list"})
+	err := testRoot.Execute()
+	want := `unknown output format "xml", valid formats: table, detail, json, ndjson, yaml, csv, tsv, markdown, raw, jsonpath=TEMPLATE, go-template=TEMPLATE`
+	if err == nil || err.Error() != want {
+		t.Fatalf("error = %v, want %q", err, want)
+	}
+	if len(fake.calls) > 0 {
+		t.Errorf("SDK called despite the unknown format: %v", fake.calls)
+	}
+}
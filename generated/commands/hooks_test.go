@@ -0,0 +1,99 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	scalingo "github.com/Scalingo/go-scalingo/v8"
+	"github.com/spf13/cobra"
+
+	"generative-cli/config"
+	"generative-cli/render"
+)
+
+// useHooks registers hooks for the duration of the test
+func useHooks(t *testing.T, key string, hooks Hooks) {
+	t.Helper()
+	RegisterHooks(key, hooks)
+	t.Cleanup(func() { delete(registeredHooks, key) })
+}
+
+func TestHooksOrder(t *testing.T) {
+	fake := useFakeClient(t)
+	var steps []string
+	useHooks(t, "apps list", Hooks{
+		PreRun: func(cmd *cobra.Command, args []string) error {
+			steps = append(steps, "pre-run")
+			return nil
+		},
+		BeforeCall: func(cmd *cobra.Command, client Client) error {
+			steps = append(steps, fmt.Sprintf("before-call (%d calls)", len(fake.calls)))
+			return nil
+		},
+		AfterCall: func(cmd *cobra.Command, result any) (any, error) {
+			steps = append(steps, fmt.Sprintf("after-call (%d calls)", len(fake.calls)))
+			return []*scalingo.App{{Name: "from-hook"}}, nil
+		},
+		Render: func(cmd *cobra.Command, result any, format render.OutputFormat) (string, error) {
+			steps = append(steps, "render")
+			apps := result.([]*scalingo.App)
+			return fmt.Sprintf("%s as %s", apps[0].Name, format), nil
+		},
+	})
+
+	out := runCommand(t, "apps", "list")
+
+	fake.expectCall(t, "AppsList", []any{})
+	want := "pre-run, before-call (0 calls), after-call (1 calls), render"
+	if got := strings.Join(steps, ", "); got != want {
+		t.Errorf("hooks ran as %q, want %q", got, want)
+	}
+	if out != "from-hook as table\n" {
+		t.Errorf("output = %q", out)
+	}
+}
+
+func TestHooksBeforeCallBeforeResolvers(t *testing.T) {
+	fake := useFakeClient(t)
+	newClient = func(ctx context.Context) (Client, error) {
+		return deploymentsClient{fake, []*scalingo.Deployment{{ID: "deploy-1"}}}, nil
+	}
+	resetFlags(t, []string{"deployments", "deployment"}, "deploy", "deployment")
+	var calls int
+	useHooks(t, "deployments deployment", Hooks{
+		BeforeCall: func(cmd *cobra.Command, client Client) error {
+			calls = len(fake.calls)
+			return nil
+		},
+	})
+
+	runCommand(t, "deployments", "deployment", "--deployment=latest")
+
+	fake.expectCall(t, "Deployment", []any{testApp, "deploy-1"})
+	if calls != 0 {
+		t.Errorf("BeforeCall ran after %d SDK calls, want none", calls)
+	}
+}
+
+func TestHooksPreRunSetsFlags(t *testing.T) {
+	fake := useFakeClient(t)
+	useHooks(t, "apps destroy", Hooks{
+		PreRun: func(cmd *cobra.Command, args []string) error {
+			return cmd.Flags().Set("current-name", config.C.GetApp())
+		},
+	})
+
+	out := runCommand(t, "apps", "destroy")
+
+	fake.expectCall(t, "AppsDestroy", []any{testApp, testApp})
+	assertSuccess(t, out, "destroy")
+}
+
+func TestRegisterHooksPanics(t *testing.T) {
+	useHooks(t, "apps show", Hooks{})
+
+	assertPanics(t, "RegisterHooks of an unknown command", func() { RegisterHooks("apps explode", Hooks{}) })
+	assertPanics(t, "RegisterHooks twice", func() { RegisterHooks("apps show", Hooks{}) })
+}
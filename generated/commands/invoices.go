@@ -2,13 +2,11 @@
 package commands
 
 import (
-	"context"
 	"fmt"
 
 	scalingo "github.com/Scalingo/go-scalingo/v8"
 	"github.com/spf13/cobra"
 
-	"generative-cli/config"
 	"generative-cli/render"
 )
 
@@ -16,47 +14,40 @@ var invoicesListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "Invoices list",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["invoices list"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
-
-		var allResults scalingo.Invoices
-		page := 1
-		for {
-			results, meta, err := client.InvoicesList(ctx, scalingo.PaginationOpts{Page: page, PerPage: 100})
-			if err != nil {
-				fmt.Println(render.RenderError(err))
-				return err
-			}
-			allResults = append(allResults, results...)
-			if meta.NextPage == 0 {
-				break
-			}
-			page = meta.NextPage
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
 		}
-		result := allResults
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		err = paginate(cmd, hooks, outputFormat, func(opts scalingo.PaginationOpts) (scalingo.Invoices, scalingo.PaginationMeta, error) {
+			return client.InvoicesList(ctx, opts)
+		})
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
 
 		return nil
 	},
@@ -64,46 +55,57 @@ var invoicesListCmd = &cobra.Command{
 
 func initinvoicesListCmd() {
 
-	invoicesListCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
+	addPaginationFlags(invoicesListCmd)
+
+	addListFlags(invoicesListCmd)
+
 }
 
 var invoicesInvoiceShowCmd = &cobra.Command{
 	Use:   "invoice-show",
 	Short: "Invoices invoice-show",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["invoices invoice-show"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		client, err := newClient(ctx)
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		string, _ := cmd.Flags().GetString("string")
 
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
 		result, err := client.InvoiceShow(ctx, string)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -113,7 +115,6 @@ func initinvoicesInvoiceShowCmd() {
 
 	invoicesInvoiceShowCmd.Flags().String("string", "", "string parameter")
 
-	invoicesInvoiceShowCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 // RegisterInvoicesServiceCommands registers all generated commands with the parent
@@ -2,13 +2,10 @@
 package commands
 
 import (
-	"context"
 	"fmt"
 
-	scalingo "github.com/Scalingo/go-scalingo/v8"
 	"github.com/spf13/cobra"
 
-	"generative-cli/config"
 	"generative-cli/render"
 )
 
@@ -16,37 +13,53 @@ var keysListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "Keys list",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["keys list"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		result, err := client.KeysList(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		if browsing(cmd) {
+			if err := browse(cmd, hooks, result); err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+			return nil
+		}
+
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -54,48 +67,57 @@ var keysListCmd = &cobra.Command{
 
 func initkeysListCmd() {
 
-	keysListCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
+	addListFlags(keysListCmd)
+
 }
 
 var keysAddCmd = &cobra.Command{
 	Use:   "add",
 	Short: "Keys add",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["keys add"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		client, err := newClient(ctx)
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		name, _ := cmd.Flags().GetString("name")
 
 		content, _ := cmd.Flags().GetString("content")
 
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
 		result, err := client.KeysAdd(ctx, name, content)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -107,37 +129,68 @@ func initkeysAddCmd() {
 
 	keysAddCmd.Flags().String("content", "", "content parameter")
 
-	keysAddCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var keysDeleteCmd = &cobra.Command{
 	Use:   "delete",
 	Short: "Keys delete",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["keys delete"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
 		id, _ := cmd.Flags().GetString("id")
 
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		// Resolve --id or --key from KeysList, IDs being used as is
+		idSelector, _ := cmd.Flags().GetString("key")
+		if looksLikeID(idSelector) {
+			id = idSelector
+		} else if idSelector != "" || id != "" && !looksLikeID(id) {
+			idList, err := client.KeysList(ctx)
+			if err == nil {
+				idItems := make([]resolveItem, len(idList))
+				for i, item := range idList {
+					idItems[i].ID = item.ID
+					idItems[i].Names = []string{item.Name}
+					idItems[i].CreatedAt = item.CreatedAt
+				}
+				if idSelector != "" {
+					id, err = resolveID("key", idSelector, idItems, true)
+				} else {
+					id, err = resolveName("key", id, idItems, true)
+				}
+			} else if idSelector == "" {
+				// --id goes to the API as given when it can't be listed
+				err = nil
+			}
+			if err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+		}
+
 		if err := client.KeysDelete(ctx, id); err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(render.RenderSuccess("delete completed successfully"))
+		fmt.Fprintln(cmd.OutOrStdout(), render.RenderSuccess("delete completed successfully"))
 
 		return nil
 	},
@@ -145,9 +198,12 @@ var keysDeleteCmd = &cobra.Command{
 
 func initkeysDeleteCmd() {
 
-	keysDeleteCmd.Flags().StringP("id", "i", "", "id parameter")
+	keysDeleteCmd.Flags().StringP("id", "i", "", "Key ID or name")
+
+	keysDeleteCmd.Flags().String("key", "", "Key to use instead of --id: latest, or its ID or name")
+
+	keysDeleteCmd.MarkFlagsMutuallyExclusive("id", "key")
 
-	keysDeleteCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 // RegisterKeysServiceCommands registers all generated commands with the parent
@@ -0,0 +1,130 @@
+package commands
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	scalingo "github.com/Scalingo/go-scalingo/v8"
+
+	"generative-cli/config"
+	"generative-cli/render"
+)
+
+// listClient lists apps in no particular order
+type listClient struct {
+	*fakeClient
+}
+
+func (c listClient) AppsList(ctx context.Context) ([]*scalingo.App, error) {
+	apps := []*scalingo.App{
+		{ID: "app-1", Name: "web-b", CreatedAt: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{ID: "app-2", Name: "api", CreatedAt: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{ID: "app-3", Name: "web-a", CreatedAt: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	c.record("AppsList", nil, apps)
+	return apps, nil
+}
+
+// runAppsList runs apps list with args, printing the app IDs
+func runAppsList(t *testing.T, args ...string) string {
+	t.Helper()
+	fake := useFakeClient(t)
+	newClient = func(ctx context.Context) (Client, error) {
+		return listClient{fake}, nil
+	}
+	resetFlags(t, []string{"apps", "list"}, "sort-by", "filter")
+	config.C.OutputFlag = "jsonpath={[*].ID}"
+	return runCommand(t, append([]string{"apps", "list"}, args...)...)
+}
+
+func TestListSortBy(t *testing.T) {
+	tests := map[string]string{
+		"name":               "app-2 app-3 app-1\n",
+		"-created_at":        "app-1 app-3 app-2\n",
+		"CreatedAt":          "app-2 app-3 app-1\n",
+		"-NAME":              "app-1 app-3 app-2\n",
+		"name,created_at":    "app-2 app-3 app-1\n",
+		"id --sort-by=-Name": "app-1 app-2 app-3\n",
+	}
+	for sortBy, want := range tests {
+		t.Run(sortBy, func(t *testing.T) {
+			out := runAppsList(t, strings.Split("--sort-by="+sortBy, " ")...)
+			assertOutput(t, out, want)
+		})
+	}
+}
+
+func TestListFilter(t *testing.T) {
+	tests := map[string]string{
+		"name=API":                        "app-2\n",
+		"name~web":                        "app-1 app-3\n",
+		"name!~web":                       "app-2\n",
+		"name~web,id!=app-1":              "app-3\n",
+		"created_at>=2026-02-01":          "app-1 app-3\n",
+		"created_at<2026-02-01T00:00:00Z": "app-2\n",
+		"name=nothing":                    "\n",
+	}
+	for filter, want := range tests {
+		t.Run(filter, func(t *testing.T) {
+			out := runAppsList(t, "--filter="+filter)
+			assertOutput(t, out, want)
+		})
+	}
+}
+
+func TestListFilterAndSort(t *testing.T) {
+	out := runAppsList(t, "--filter=name~web", "--sort-by=name")
+	config.C.OutputFlag = string(render.FormatTable)
+	table := runCommand(t, "apps", "list", "--filter=name~web", "--sort-by=name")
+
+	assertOutput(t, out, "app-3 app-1\n")
+	if a, b := strings.Index(table, "web-a"), strings.Index(table, "web-b"); a < 0 || b < a || strings.Contains(table, "api") {
+		t.Errorf("table not filtered and sorted:\n%s", table)
+	}
+}
+
+func TestListPages(t *testing.T) {
+	runEvents(t, render.FormatCSV)
+	resetFlags(t, []string{"events", "list"}, "sort-by", "filter")
+	filtered := runCommand(t, "events", "list", "--all", "--filter=id!=event-1")
+	sorted := runCommand(t, "events", "list", "--all", "--filter=", "--sort-by=-created_at")
+
+	assertOutput(t, filtered, "ID,Name,CreatedAt\nevent-2,\"deploy, \"\"web\"\"\",2026-01-02T00:00:00Z\n")
+	assertOutput(t, sorted, "ID,Name,CreatedAt\n"+
+		"event-2,\"deploy, \"\"web\"\"\",2026-01-02T00:00:00Z\n"+
+		"event-1,\"deploy, \"\"web\"\"\",2026-01-01T00:00:00Z\n")
+}
+
+func TestListPagesLimitFiltered(t *testing.T) {
+	runEvents(t, render.FormatCSV)
+	resetFlags(t, []string{"events", "list"}, "sort-by", "filter")
+
+	// The first page is filtered out, so --limit fetches the second one
+	out := runCommand(t, "events", "list", "--limit=1", "--filter=id!=event-1")
+
+	assertOutput(t, out, "ID,Name,CreatedAt\nevent-2,\"deploy, \"\"web\"\"\",2026-01-02T00:00:00Z\n")
+}
+
+func TestListInvalid(t *testing.T) {
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"--filter=name"}, `invalid condition "name"`},
+		{[]string{"--sort-by=,"}, "expected fields separated by commas"},
+		{[]string{"--filter=owner=me"}, `--filter: unknown column "owner", available columns: ID, Name, CreatedAt`},
+		{[]string{"--sort-by=size"}, `--sort-by: unknown column "size"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.args[0], func(t *testing.T) {
+			runAppsList(t)
+			testRoot.SetArgs(append([]string{"apps", "list"}, tt.args...))
+			err := testRoot.Execute()
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
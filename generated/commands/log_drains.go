@@ -2,7 +2,6 @@
 package commands
 
 import (
-	"context"
 	"fmt"
 
 	scalingo "github.com/Scalingo/go-scalingo/v8"
@@ -16,39 +15,59 @@ var logDrainsListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "LogDrains list",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["log-drains list"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		app, _ := cmd.Flags().GetString("app")
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		result, err := client.LogDrainsList(ctx, app)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		if browsing(cmd) {
+			if err := browse(cmd, hooks, result); err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+			return nil
+		}
+
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -56,39 +75,45 @@ var logDrainsListCmd = &cobra.Command{
 
 func initlogDrainsListCmd() {
 
-	logDrainsListCmd.Flags().StringP("app", "a", "", "app parameter")
+	addListFlags(logDrainsListCmd)
 
-	logDrainsListCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var logDrainsLogDrainAddCmd = &cobra.Command{
 	Use:   "log-drain-add",
 	Short: "LogDrains log-drain-add",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["log-drains log-drain-add"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		client, err := newClient(ctx)
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		app, _ := cmd.Flags().GetString("app")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		typeFlag, _ := cmd.Flags().GetString("type")
 
-		uRLFlag, _ := cmd.Flags().GetString("---a-c-r1--")
+		urlFlag, _ := cmd.Flags().GetString("url")
 
 		portFlag, _ := cmd.Flags().GetString("port")
 
@@ -98,9 +123,14 @@ var logDrainsLogDrainAddCmd = &cobra.Command{
 
 		drainRegionFlag, _ := cmd.Flags().GetString("drain-region")
 
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
 		params := scalingo.LogDrainAddParams{
 			Type:        typeFlag,
-			URL:         uRLFlag,
+			URL:         urlFlag,
 			Port:        portFlag,
 			Host:        hostFlag,
 			Token:       tokenFlag,
@@ -109,16 +139,16 @@ var logDrainsLogDrainAddCmd = &cobra.Command{
 
 		result, err := client.LogDrainAdd(ctx, app, params)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -126,11 +156,9 @@ var logDrainsLogDrainAddCmd = &cobra.Command{
 
 func initlogDrainsLogDrainAddCmd() {
 
-	logDrainsLogDrainAddCmd.Flags().StringP("app", "a", "", "app parameter")
-
 	logDrainsLogDrainAddCmd.Flags().String("type", "", "Type field")
 
-	logDrainsLogDrainAddCmd.Flags().String("---a-c-r1--", "", "URL field")
+	logDrainsLogDrainAddCmd.Flags().String("url", "", "URL field")
 
 	logDrainsLogDrainAddCmd.Flags().String("port", "", "Port field")
 
@@ -140,39 +168,46 @@ func initlogDrainsLogDrainAddCmd() {
 
 	logDrainsLogDrainAddCmd.Flags().String("drain-region", "", "DrainRegion field")
 
-	logDrainsLogDrainAddCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var logDrainsLogDrainRemoveCmd = &cobra.Command{
 	Use:   "log-drain-remove",
 	Short: "LogDrains log-drain-remove",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
+
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["log-drains log-drain-remove"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		authToken, err := config.C.LoadAuth()
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		app, err := config.C.RequireApp()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		app, _ := cmd.Flags().GetString("app")
+		url, _ := cmd.Flags().GetString("url")
 
-		uRL, _ := cmd.Flags().GetString("u-r-l")
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		if err := client.LogDrainRemove(ctx, app, uRL); err != nil {
-			fmt.Println(render.RenderError(err))
+		if err := client.LogDrainRemove(ctx, app, url); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(render.RenderSuccess("log-drain-remove completed successfully"))
+		fmt.Fprintln(cmd.OutOrStdout(), render.RenderSuccess("log-drain-remove completed successfully"))
 
 		return nil
 	},
@@ -180,45 +215,78 @@ var logDrainsLogDrainRemoveCmd = &cobra.Command{
 
 func initlogDrainsLogDrainRemoveCmd() {
 
-	logDrainsLogDrainRemoveCmd.Flags().StringP("app", "a", "", "app parameter")
+	logDrainsLogDrainRemoveCmd.Flags().String("url", "", "URL parameter")
 
-	logDrainsLogDrainRemoveCmd.Flags().String("u-r-l", "", "URL parameter")
-
-	logDrainsLogDrainRemoveCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var logDrainsLogDrainAddonRemoveCmd = &cobra.Command{
 	Use:   "log-drain-addon-remove",
 	Short: "LogDrains log-drain-addon-remove",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["log-drains log-drain-addon-remove"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		app, err := config.C.RequireApp()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		app, _ := cmd.Flags().GetString("app")
+		addonID, _ := cmd.Flags().GetString("addon-id")
+
+		url, _ := cmd.Flags().GetString("url")
 
-		addonID, _ := cmd.Flags().GetString("addon-i-d")
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		uRL, _ := cmd.Flags().GetString("u-r-l")
+		// Resolve --addon-id or --addon from AddonsList, IDs being used as is
+		addonIDSelector, _ := cmd.Flags().GetString("addon")
+		if looksLikeID(addonIDSelector) {
+			addonID = addonIDSelector
+		} else if addonIDSelector != "" || addonID != "" && !looksLikeID(addonID) {
+			addonIDList, err := client.AddonsList(ctx, app)
+			if err == nil {
+				addonIDItems := make([]resolveItem, len(addonIDList))
+				for i, item := range addonIDList {
+					addonIDItems[i].ID = item.ID
+					addonIDItems[i].Names = []string{item.Name}
+					addonIDItems[i].CreatedAt = item.CreatedAt
+				}
+				if addonIDSelector != "" {
+					addonID, err = resolveID("addon", addonIDSelector, addonIDItems, true)
+				} else {
+					addonID, err = resolveName("addon", addonID, addonIDItems, true)
+				}
+			} else if addonIDSelector == "" {
+				// --addon-id goes to the API as given when it can't be listed
+				err = nil
+			}
+			if err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+		}
 
-		if err := client.LogDrainAddonRemove(ctx, app, addonID, uRL); err != nil {
-			fmt.Println(render.RenderError(err))
+		if err := client.LogDrainAddonRemove(ctx, app, addonID, url); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(render.RenderSuccess("log-drain-addon-remove completed successfully"))
+		fmt.Fprintln(cmd.OutOrStdout(), render.RenderSuccess("log-drain-addon-remove completed successfully"))
 
 		return nil
 	},
@@ -226,54 +294,103 @@ var logDrainsLogDrainAddonRemoveCmd = &cobra.Command{
 
 func initlogDrainsLogDrainAddonRemoveCmd() {
 
-	logDrainsLogDrainAddonRemoveCmd.Flags().StringP("app", "a", "", "app parameter")
+	logDrainsLogDrainAddonRemoveCmd.Flags().String("addon-id", "", "Addon ID or name")
+
+	logDrainsLogDrainAddonRemoveCmd.Flags().String("addon", "", "Addon to use instead of --addon-id: latest, or its ID or name")
 
-	logDrainsLogDrainAddonRemoveCmd.Flags().String("addon-i-d", "", "addonID parameter")
+	logDrainsLogDrainAddonRemoveCmd.Flags().String("url", "", "URL parameter")
 
-	logDrainsLogDrainAddonRemoveCmd.Flags().String("u-r-l", "", "URL parameter")
+	logDrainsLogDrainAddonRemoveCmd.MarkFlagsMutuallyExclusive("addon-id", "addon")
 
-	logDrainsLogDrainAddonRemoveCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var logDrainsAddonListCmd = &cobra.Command{
 	Use:   "addon-list",
 	Short: "LogDrains addon-list",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
+
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["log-drains addon-list"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		outputFormat, err := selectedFormat()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		authToken, err := config.C.LoadAuth()
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		app, err := config.C.RequireApp()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		addonID, _ := cmd.Flags().GetString("addon-id")
 
-		app, _ := cmd.Flags().GetString("app")
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		addonID, _ := cmd.Flags().GetString("addon-i-d")
+		// Resolve --addon-id or --addon from AddonsList, IDs being used as is
+		addonIDSelector, _ := cmd.Flags().GetString("addon")
+		if looksLikeID(addonIDSelector) {
+			addonID = addonIDSelector
+		} else if addonIDSelector != "" || addonID != "" && !looksLikeID(addonID) {
+			addonIDList, err := client.AddonsList(ctx, app)
+			if err == nil {
+				addonIDItems := make([]resolveItem, len(addonIDList))
+				for i, item := range addonIDList {
+					addonIDItems[i].ID = item.ID
+					addonIDItems[i].Names = []string{item.Name}
+					addonIDItems[i].CreatedAt = item.CreatedAt
+				}
+				if addonIDSelector != "" {
+					addonID, err = resolveID("addon", addonIDSelector, addonIDItems, false)
+				} else {
+					addonID, err = resolveName("addon", addonID, addonIDItems, false)
+				}
+			} else if addonIDSelector == "" {
+				// --addon-id goes to the API as given when it can't be listed
+				err = nil
+			}
+			if err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+		}
 
 		result, err := client.LogDrainsAddonList(ctx, app, addonID)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		if browsing(cmd) {
+			if err := browse(cmd, hooks, result); err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+			return nil
+		}
+
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -281,43 +398,53 @@ var logDrainsAddonListCmd = &cobra.Command{
 
 func initlogDrainsAddonListCmd() {
 
-	logDrainsAddonListCmd.Flags().StringP("app", "a", "", "app parameter")
+	logDrainsAddonListCmd.Flags().String("addon-id", "", "Addon ID, name or unique prefix")
 
-	logDrainsAddonListCmd.Flags().String("addon-i-d", "", "addonID parameter")
+	logDrainsAddonListCmd.Flags().String("addon", "", "Addon to use instead of --addon-id: latest, or its ID, name or unique prefix")
+
+	addListFlags(logDrainsAddonListCmd)
+
+	logDrainsAddonListCmd.MarkFlagsMutuallyExclusive("addon-id", "addon")
 
-	logDrainsAddonListCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var logDrainsLogDrainAddonAddCmd = &cobra.Command{
 	Use:   "log-drain-addon-add",
 	Short: "LogDrains log-drain-addon-add",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["log-drains log-drain-addon-add"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		client, err := newClient(ctx)
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		app, _ := cmd.Flags().GetString("app")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		addonID, _ := cmd.Flags().GetString("addon-i-d")
+		addonID, _ := cmd.Flags().GetString("addon-id")
 
 		typeFlag, _ := cmd.Flags().GetString("type")
 
-		uRLFlag, _ := cmd.Flags().GetString("---a-c-r1--")
+		urlFlag, _ := cmd.Flags().GetString("url")
 
 		portFlag, _ := cmd.Flags().GetString("port")
 
@@ -327,9 +454,42 @@ var logDrainsLogDrainAddonAddCmd = &cobra.Command{
 
 		drainRegionFlag, _ := cmd.Flags().GetString("drain-region")
 
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		// Resolve --addon-id or --addon from AddonsList, IDs being used as is
+		addonIDSelector, _ := cmd.Flags().GetString("addon")
+		if looksLikeID(addonIDSelector) {
+			addonID = addonIDSelector
+		} else if addonIDSelector != "" || addonID != "" && !looksLikeID(addonID) {
+			addonIDList, err := client.AddonsList(ctx, app)
+			if err == nil {
+				addonIDItems := make([]resolveItem, len(addonIDList))
+				for i, item := range addonIDList {
+					addonIDItems[i].ID = item.ID
+					addonIDItems[i].Names = []string{item.Name}
+					addonIDItems[i].CreatedAt = item.CreatedAt
+				}
+				if addonIDSelector != "" {
+					addonID, err = resolveID("addon", addonIDSelector, addonIDItems, false)
+				} else {
+					addonID, err = resolveName("addon", addonID, addonIDItems, false)
+				}
+			} else if addonIDSelector == "" {
+				// --addon-id goes to the API as given when it can't be listed
+				err = nil
+			}
+			if err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+		}
+
 		params := scalingo.LogDrainAddParams{
 			Type:        typeFlag,
-			URL:         uRLFlag,
+			URL:         urlFlag,
 			Port:        portFlag,
 			Host:        hostFlag,
 			Token:       tokenFlag,
@@ -338,16 +498,16 @@ var logDrainsLogDrainAddonAddCmd = &cobra.Command{
 
 		result, err := client.LogDrainAddonAdd(ctx, app, addonID, params)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -355,13 +515,13 @@ var logDrainsLogDrainAddonAddCmd = &cobra.Command{
 
 func initlogDrainsLogDrainAddonAddCmd() {
 
-	logDrainsLogDrainAddonAddCmd.Flags().StringP("app", "a", "", "app parameter")
+	logDrainsLogDrainAddonAddCmd.Flags().String("addon-id", "", "Addon ID, name or unique prefix")
 
-	logDrainsLogDrainAddonAddCmd.Flags().String("addon-i-d", "", "addonID parameter")
+	logDrainsLogDrainAddonAddCmd.Flags().String("addon", "", "Addon to use instead of --addon-id: latest, or its ID, name or unique prefix")
 
 	logDrainsLogDrainAddonAddCmd.Flags().String("type", "", "Type field")
 
-	logDrainsLogDrainAddonAddCmd.Flags().String("---a-c-r1--", "", "URL field")
+	logDrainsLogDrainAddonAddCmd.Flags().String("url", "", "URL field")
 
 	logDrainsLogDrainAddonAddCmd.Flags().String("port", "", "Port field")
 
@@ -371,14 +531,16 @@ func initlogDrainsLogDrainAddonAddCmd() {
 
 	logDrainsLogDrainAddonAddCmd.Flags().String("drain-region", "", "DrainRegion field")
 
-	logDrainsLogDrainAddonAddCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
+	logDrainsLogDrainAddonAddCmd.MarkFlagsMutuallyExclusive("addon-id", "addon")
+
 }
 
 // RegisterLogDrainsServiceCommands registers all generated commands with the parent
 func RegisterLogDrainsServiceCommands(parent *cobra.Command) {
 	serviceCmd := &cobra.Command{
-		Use:   "log_drains",
-		Short: "LogDrainsService operations",
+		Use:     "log-drains",
+		Aliases: []string{"log_drains"},
+		Short:   "LogDrainsService operations",
 	}
 
 	initlogDrainsListCmd()
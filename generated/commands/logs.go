@@ -2,57 +2,118 @@
 package commands
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 
-	scalingo "github.com/Scalingo/go-scalingo/v8"
 	"github.com/spf13/cobra"
 
 	"generative-cli/config"
 	"generative-cli/render"
 )
 
+var logsURLCmd = &cobra.Command{
+	Use:     "url",
+	Aliases: []string{"u-r-l"},
+	Short:   "Logs url",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
+
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["logs url"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		client, err := newClient(ctx)
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		result, err := client.LogsURL(ctx, app)
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		if err := render.StreamResponse(ctx, cmd.OutOrStdout(), cmd.ErrOrStderr(), result); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		return nil
+	},
+}
+
+func initlogsURLCmd() {
+
+}
+
 var logsRunCmd = &cobra.Command{
 	Use:   "run",
 	Short: "Logs run",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["logs run"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		client, err := newClient(ctx)
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		app, _ := cmd.Flags().GetString("app")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		n, _ := cmd.Flags().GetInt("n")
 
 		filter, _ := cmd.Flags().GetString("filter")
 
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
 		// Fetch logsURL by calling LogsURL
 		logsURLResp, err := client.LogsURL(ctx, app)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 		defer logsURLResp.Body.Close()
 		logsURLBytes, err := io.ReadAll(logsURLResp.Body)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 		// Parse JSON response to extract the URL
@@ -60,23 +121,18 @@ var logsRunCmd = &cobra.Command{
 			LogsURL string `json:"logs_url"`
 		}
 		if err := json.Unmarshal(logsURLBytes, &logsURLData); err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 		logsURL := logsURLData.LogsURL
 
-		result, err := client.Logs(ctx, logsURL, n, filter)
-		if err != nil {
-			fmt.Println(render.RenderError(err))
-			return err
-		}
-
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		err = stream(cmd, outputFormat, func() (any, error) {
+			return client.Logs(ctx, logsURL, n, filter)
+		})
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
 
 		return nil
 	},
@@ -84,13 +140,12 @@ var logsRunCmd = &cobra.Command{
 
 func initlogsRunCmd() {
 
-	logsRunCmd.Flags().StringP("app", "a", "", "app parameter")
-
 	logsRunCmd.Flags().IntP("n", "n", 0, "n parameter")
 
 	logsRunCmd.Flags().StringP("filter", "F", "", "filter parameter")
 
-	logsRunCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
+	addStreamFlags(logsRunCmd)
+
 }
 
 // RegisterLogsServiceCommands registers all generated commands with the parent
@@ -100,6 +155,9 @@ func RegisterLogsServiceCommands(parent *cobra.Command) {
 		Short: "LogsService operations",
 	}
 
+	initlogsURLCmd()
+	serviceCmd.AddCommand(logsURLCmd)
+
 	initlogsRunCmd()
 	serviceCmd.AddCommand(logsRunCmd)
 
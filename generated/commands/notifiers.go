@@ -2,7 +2,6 @@
 package commands
 
 import (
-	"context"
 	"fmt"
 
 	scalingo "github.com/Scalingo/go-scalingo/v8"
@@ -16,39 +15,51 @@ var notifiersListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "Notifiers list",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["notifiers list"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		app, _ := cmd.Flags().GetString("app")
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		result, err := client.NotifiersList(ctx, app)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -56,35 +67,39 @@ var notifiersListCmd = &cobra.Command{
 
 func initnotifiersListCmd() {
 
-	notifiersListCmd.Flags().StringP("app", "a", "", "app parameter")
-
-	notifiersListCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var notifiersNotifierProvisionCmd = &cobra.Command{
 	Use:   "notifier-provision",
 	Short: "Notifiers notifier-provision",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["notifiers notifier-provision"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		client, err := newClient(ctx)
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		app, _ := cmd.Flags().GetString("app")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		activeFlag, _ := cmd.Flags().GetBool("active")
 
@@ -94,17 +109,22 @@ var notifiersNotifierProvisionCmd = &cobra.Command{
 
 		sendAllAlertsFlag, _ := cmd.Flags().GetBool("send-all-alerts")
 
-		selectedEventIDsFlag, _ := cmd.Flags().GetStringSlice("selected-event---a-c-r0--s")
+		selectedEventIDsFlag, _ := cmd.Flags().GetStringSlice("selected-event-ids")
 
-		platformIDFlag, _ := cmd.Flags().GetString("platform---a-c-r0--")
+		platformIDFlag, _ := cmd.Flags().GetString("platform-id")
 
 		phoneNumberFlag, _ := cmd.Flags().GetString("phone-number")
 
 		emailsFlag, _ := cmd.Flags().GetStringSlice("emails")
 
-		userIDsFlag, _ := cmd.Flags().GetStringSlice("user---a-c-r0--s")
+		userIDsFlag, _ := cmd.Flags().GetStringSlice("user-ids")
 
-		webhookURLFlag, _ := cmd.Flags().GetString("webhook---a-c-r1--")
+		webhookURLFlag, _ := cmd.Flags().GetString("webhook-url")
+
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		params := scalingo.NotifierParams{
 			Active:           &activeFlag,
@@ -121,16 +141,16 @@ var notifiersNotifierProvisionCmd = &cobra.Command{
 
 		result, err := client.NotifierProvision(ctx, app, params)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -138,8 +158,6 @@ var notifiersNotifierProvisionCmd = &cobra.Command{
 
 func initnotifiersNotifierProvisionCmd() {
 
-	notifiersNotifierProvisionCmd.Flags().StringP("app", "a", "", "app parameter")
-
 	notifiersNotifierProvisionCmd.Flags().Bool("active", false, "Active field")
 
 	notifiersNotifierProvisionCmd.Flags().String("name", "", "Name field")
@@ -148,60 +166,72 @@ func initnotifiersNotifierProvisionCmd() {
 
 	notifiersNotifierProvisionCmd.Flags().Bool("send-all-alerts", false, "SendAllAlerts field")
 
-	notifiersNotifierProvisionCmd.Flags().StringSlice("selected-event---a-c-r0--s", nil, "SelectedEventIDs field")
+	notifiersNotifierProvisionCmd.Flags().StringSlice("selected-event-ids", nil, "SelectedEventIDs field")
 
-	notifiersNotifierProvisionCmd.Flags().String("platform---a-c-r0--", "", "PlatformID field")
+	notifiersNotifierProvisionCmd.Flags().String("platform-id", "", "PlatformID field")
 
 	notifiersNotifierProvisionCmd.Flags().String("phone-number", "", "PhoneNumber field")
 
 	notifiersNotifierProvisionCmd.Flags().StringSlice("emails", nil, "Emails field")
 
-	notifiersNotifierProvisionCmd.Flags().StringSlice("user---a-c-r0--s", nil, "UserIDs field")
+	notifiersNotifierProvisionCmd.Flags().StringSlice("user-ids", nil, "UserIDs field")
 
-	notifiersNotifierProvisionCmd.Flags().String("webhook---a-c-r1--", "", "WebhookURL field")
+	notifiersNotifierProvisionCmd.Flags().String("webhook-url", "", "WebhookURL field")
 
-	notifiersNotifierProvisionCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var notifiersNotifierByIDCmd = &cobra.Command{
-	Use:   "notifier-by-i-d",
-	Short: "Notifiers notifier-by-i-d",
+	Use:     "notifier-by-id",
+	Aliases: []string{"notifier-by-i-d"},
+	Short:   "Notifiers notifier-by-id",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
+
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["notifiers notifier-by-id"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		authToken, err := config.C.LoadAuth()
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		app, _ := cmd.Flags().GetString("app")
+		id, _ := cmd.Flags().GetString("id")
 
-		iD, _ := cmd.Flags().GetString("i-d")
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		result, err := client.NotifierByID(ctx, app, iD)
+		result, err := client.NotifierByID(ctx, app, id)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -209,39 +239,43 @@ var notifiersNotifierByIDCmd = &cobra.Command{
 
 func initnotifiersNotifierByIDCmd() {
 
-	notifiersNotifierByIDCmd.Flags().StringP("app", "a", "", "app parameter")
-
-	notifiersNotifierByIDCmd.Flags().StringP("i-d", "i", "", "ID parameter")
+	notifiersNotifierByIDCmd.Flags().StringP("id", "i", "", "ID parameter")
 
-	notifiersNotifierByIDCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var notifiersNotifierUpdateCmd = &cobra.Command{
 	Use:   "notifier-update",
 	Short: "Notifiers notifier-update",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["notifiers notifier-update"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		client, err := newClient(ctx)
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		app, _ := cmd.Flags().GetString("app")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		iD, _ := cmd.Flags().GetString("i-d")
+		id, _ := cmd.Flags().GetString("id")
 
 		activeFlag, _ := cmd.Flags().GetBool("active")
 
@@ -251,17 +285,22 @@ var notifiersNotifierUpdateCmd = &cobra.Command{
 
 		sendAllAlertsFlag, _ := cmd.Flags().GetBool("send-all-alerts")
 
-		selectedEventIDsFlag, _ := cmd.Flags().GetStringSlice("selected-event---a-c-r0--s")
+		selectedEventIDsFlag, _ := cmd.Flags().GetStringSlice("selected-event-ids")
 
-		platformIDFlag, _ := cmd.Flags().GetString("platform---a-c-r0--")
+		platformIDFlag, _ := cmd.Flags().GetString("platform-id")
 
 		phoneNumberFlag, _ := cmd.Flags().GetString("phone-number")
 
 		emailsFlag, _ := cmd.Flags().GetStringSlice("emails")
 
-		userIDsFlag, _ := cmd.Flags().GetStringSlice("user---a-c-r0--s")
+		userIDsFlag, _ := cmd.Flags().GetStringSlice("user-ids")
+
+		webhookURLFlag, _ := cmd.Flags().GetString("webhook-url")
 
-		webhookURLFlag, _ := cmd.Flags().GetString("webhook---a-c-r1--")
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		params := scalingo.NotifierParams{
 			Active:           &activeFlag,
@@ -276,18 +315,18 @@ var notifiersNotifierUpdateCmd = &cobra.Command{
 			WebhookURL:       webhookURLFlag,
 		}
 
-		result, err := client.NotifierUpdate(ctx, app, iD, params)
+		result, err := client.NotifierUpdate(ctx, app, id, params)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -295,9 +334,7 @@ var notifiersNotifierUpdateCmd = &cobra.Command{
 
 func initnotifiersNotifierUpdateCmd() {
 
-	notifiersNotifierUpdateCmd.Flags().StringP("app", "a", "", "app parameter")
-
-	notifiersNotifierUpdateCmd.Flags().StringP("i-d", "i", "", "ID parameter")
+	notifiersNotifierUpdateCmd.Flags().StringP("id", "i", "", "ID parameter")
 
 	notifiersNotifierUpdateCmd.Flags().Bool("active", false, "Active field")
 
@@ -307,51 +344,58 @@ func initnotifiersNotifierUpdateCmd() {
 
 	notifiersNotifierUpdateCmd.Flags().Bool("send-all-alerts", false, "SendAllAlerts field")
 
-	notifiersNotifierUpdateCmd.Flags().StringSlice("selected-event---a-c-r0--s", nil, "SelectedEventIDs field")
+	notifiersNotifierUpdateCmd.Flags().StringSlice("selected-event-ids", nil, "SelectedEventIDs field")
 
-	notifiersNotifierUpdateCmd.Flags().String("platform---a-c-r0--", "", "PlatformID field")
+	notifiersNotifierUpdateCmd.Flags().String("platform-id", "", "PlatformID field")
 
 	notifiersNotifierUpdateCmd.Flags().String("phone-number", "", "PhoneNumber field")
 
 	notifiersNotifierUpdateCmd.Flags().StringSlice("emails", nil, "Emails field")
 
-	notifiersNotifierUpdateCmd.Flags().StringSlice("user---a-c-r0--s", nil, "UserIDs field")
+	notifiersNotifierUpdateCmd.Flags().StringSlice("user-ids", nil, "UserIDs field")
 
-	notifiersNotifierUpdateCmd.Flags().String("webhook---a-c-r1--", "", "WebhookURL field")
+	notifiersNotifierUpdateCmd.Flags().String("webhook-url", "", "WebhookURL field")
 
-	notifiersNotifierUpdateCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var notifiersNotifierDestroyCmd = &cobra.Command{
 	Use:   "notifier-destroy",
 	Short: "Notifiers notifier-destroy",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["notifiers notifier-destroy"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		app, err := config.C.RequireApp()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		app, _ := cmd.Flags().GetString("app")
+		id, _ := cmd.Flags().GetString("id")
 
-		iD, _ := cmd.Flags().GetString("i-d")
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		if err := client.NotifierDestroy(ctx, app, iD); err != nil {
-			fmt.Println(render.RenderError(err))
+		if err := client.NotifierDestroy(ctx, app, id); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(render.RenderSuccess("notifier-destroy completed successfully"))
+		fmt.Fprintln(cmd.OutOrStdout(), render.RenderSuccess("notifier-destroy completed successfully"))
 
 		return nil
 	},
@@ -359,11 +403,8 @@ var notifiersNotifierDestroyCmd = &cobra.Command{
 
 func initnotifiersNotifierDestroyCmd() {
 
-	notifiersNotifierDestroyCmd.Flags().StringP("app", "a", "", "app parameter")
-
-	notifiersNotifierDestroyCmd.Flags().StringP("i-d", "i", "", "ID parameter")
+	notifiersNotifierDestroyCmd.Flags().StringP("id", "i", "", "ID parameter")
 
-	notifiersNotifierDestroyCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 // RegisterNotifiersServiceCommands registers all generated commands with the parent
@@ -0,0 +1,120 @@
+package commands
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	scalingo "github.com/Scalingo/go-scalingo/v8"
+	"github.com/spf13/cobra"
+
+	"generative-cli/config"
+	"generative-cli/render"
+)
+
+// paginatedCommand is a paginated command of the test tree
+var paginatedCommand = []string{"deployments", "deployment-list-with-pagination"}
+
+// runPaginated runs paginatedCommand with args, against a fake client returning pages
+func runPaginated(t *testing.T, pages int, args ...string) (*fakeClient, string) {
+	t.Helper()
+	fake := useFakeClient(t)
+	fake.pages = pages
+	resetFlags(t, paginatedCommand, "page", "per-page", "limit", "all")
+	return fake, runCommand(t, append(paginatedCommand, args...)...)
+}
+
+// assertPageCalls checks the pagination options of the calls to the paginated method
+func assertPageCalls(t *testing.T, fake *fakeClient, want ...scalingo.PaginationOpts) []fakeCall {
+	t.Helper()
+	calls := fake.callsTo("DeploymentListWithPagination")
+	if len(calls) != len(want) {
+		t.Fatalf("DeploymentListWithPagination called %d times, want %d", len(calls), len(want))
+	}
+	for i, call := range calls {
+		assertArgs(t, call, []any{testApp, want[i]})
+	}
+	return calls
+}
+
+func TestPaginationFirstPage(t *testing.T) {
+	fake, out := runPaginated(t, 3)
+
+	assertPageCalls(t, fake, scalingo.PaginationOpts{Page: 1, PerPage: defaultPerPage})
+	if !strings.Contains(out, "Page 1 · 1 item · more with --page 2 or --all") {
+		t.Errorf("footer missing from output:\n%s", out)
+	}
+}
+
+func TestPaginationPage(t *testing.T) {
+	fake, out := runPaginated(t, 3, "--page=3", "--per-page=10")
+
+	assertPageCalls(t, fake, scalingo.PaginationOpts{Page: 3, PerPage: 10})
+	if !strings.Contains(out, "Page 3 · 1 item\n") {
+		t.Errorf("footer missing from output:\n%s", out)
+	}
+}
+
+func TestPaginationLimit(t *testing.T) {
+	fake, out := runPaginated(t, 3, "--limit=2")
+
+	assertPageCalls(t, fake,
+		scalingo.PaginationOpts{Page: 1, PerPage: 2},
+		scalingo.PaginationOpts{Page: 2, PerPage: 2},
+	)
+	if !strings.HasSuffix(out, "Page 2 · 2 items · more with --page 3 or --all\n") {
+		t.Errorf("footer missing from output:\n%s", out)
+	}
+}
+
+func TestPaginationNDJSON(t *testing.T) {
+	fake := useFakeClient(t)
+	fake.pages = 2
+	config.C.OutputFlag = string(render.FormatNDJSON)
+	resetFlags(t, paginatedCommand, "page", "per-page", "limit", "all")
+
+	out := runCommand(t, append(paginatedCommand, "--all")...)
+
+	calls := assertPageCalls(t, fake,
+		scalingo.PaginationOpts{Page: 1, PerPage: defaultPerPage},
+		scalingo.PaginationOpts{Page: 2, PerPage: defaultPerPage},
+	)
+	var want strings.Builder
+	for _, call := range calls {
+		for _, deployment := range call.Result.([]*scalingo.Deployment) {
+			line, err := json.Marshal(deployment)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want.Write(append(line, '\n'))
+		}
+	}
+	assertOutput(t, out, want.String())
+}
+
+func TestPaginationHooksRender(t *testing.T) {
+	useHooks(t, "deployments deployment-list-with-pagination", Hooks{
+		AfterCall: func(cmd *cobra.Command, result any) (any, error) {
+			return len(result.([]*scalingo.Deployment)), nil
+		},
+	})
+	fake, out := runPaginated(t, 3, "--all")
+
+	assertPageCalls(t, fake,
+		scalingo.PaginationOpts{Page: 1, PerPage: defaultPerPage},
+		scalingo.PaginationOpts{Page: 2, PerPage: defaultPerPage},
+		scalingo.PaginationOpts{Page: 3, PerPage: defaultPerPage},
+	)
+	assertOutput(t, out, "3\n"+render.RenderPageFooter(render.FormatTable, scalingo.PaginationMeta{}, 3, 3)+"\n")
+}
+
+func TestPaginationInvalidFlags(t *testing.T) {
+	useFakeClient(t)
+	resetFlags(t, paginatedCommand, "page", "per-page", "limit", "all")
+
+	testRoot.SetArgs(append(paginatedCommand, "--page=0"))
+	err := testRoot.Execute()
+	if err == nil || err.Error() != "--page must be at least 1, got 0" {
+		t.Errorf("error = %v", err)
+	}
+}
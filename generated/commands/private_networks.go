@@ -2,10 +2,8 @@
 package commands
 
 import (
-	"context"
 	"fmt"
 
-	scalingo "github.com/Scalingo/go-scalingo/v8"
 	"github.com/spf13/cobra"
 
 	"generative-cli/config"
@@ -16,43 +14,55 @@ var privateNetworksDomainsListCmd = &cobra.Command{
 	Use:   "domains-list",
 	Short: "PrivateNetworks domains-list",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["private-networks domains-list"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		client, err := newClient(ctx)
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		app, _ := cmd.Flags().GetString("app")
+		app, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		page, _ := cmd.Flags().GetUint("page")
 
 		perPage, _ := cmd.Flags().GetUint("per-page")
 
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
 		result, err := client.PrivateNetworksDomainsList(ctx, app, page, perPage)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -60,20 +70,18 @@ var privateNetworksDomainsListCmd = &cobra.Command{
 
 func initprivateNetworksDomainsListCmd() {
 
-	privateNetworksDomainsListCmd.Flags().StringP("app", "a", "", "app parameter")
-
 	privateNetworksDomainsListCmd.Flags().Uint("page", 0, "page parameter")
 
 	privateNetworksDomainsListCmd.Flags().Uint("per-page", 0, "perPage parameter")
 
-	privateNetworksDomainsListCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 // RegisterPrivateNetworksServiceCommands registers all generated commands with the parent
 func RegisterPrivateNetworksServiceCommands(parent *cobra.Command) {
 	serviceCmd := &cobra.Command{
-		Use:   "private_networks",
-		Short: "PrivateNetworksService operations",
+		Use:     "private-networks",
+		Aliases: []string{"private_networks"},
+		Short:   "PrivateNetworksService operations",
 	}
 
 	initprivateNetworksDomainsListCmd()
@@ -2,13 +2,11 @@
 package commands
 
 import (
-	"context"
 	"fmt"
 
 	scalingo "github.com/Scalingo/go-scalingo/v8"
 	"github.com/spf13/cobra"
 
-	"generative-cli/config"
 	"generative-cli/render"
 )
 
@@ -16,37 +14,53 @@ var projectsListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "Projects list",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["projects list"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		result, err := client.ProjectsList(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		if browsing(cmd) {
+			if err := browse(cmd, hooks, result); err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+			return nil
+		}
+
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -54,36 +68,45 @@ var projectsListCmd = &cobra.Command{
 
 func initprojectsListCmd() {
 
-	projectsListCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
+	addListFlags(projectsListCmd)
+
 }
 
 var projectsProjectAddCmd = &cobra.Command{
 	Use:   "project-add",
 	Short: "Projects project-add",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["projects project-add"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		client, err := newClient(ctx)
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		nameFlag, _ := cmd.Flags().GetString("name")
 
 		defaultFlag, _ := cmd.Flags().GetBool("default")
 
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
 		params := scalingo.ProjectAddParams{
 			Name:    nameFlag,
 			Default: defaultFlag,
@@ -91,16 +114,16 @@ var projectsProjectAddCmd = &cobra.Command{
 
 		result, err := client.ProjectAdd(ctx, params)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -112,38 +135,73 @@ func initprojectsProjectAddCmd() {
 
 	projectsProjectAddCmd.Flags().Bool("default", false, "Default field")
 
-	projectsProjectAddCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var projectsProjectUpdateCmd = &cobra.Command{
 	Use:   "project-update",
 	Short: "Projects project-update",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["projects project-update"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		client, err := newClient(ctx)
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		projectID, _ := cmd.Flags().GetString("project-i-d")
+		projectID, _ := cmd.Flags().GetString("project-id")
 
 		nameFlag, _ := cmd.Flags().GetString("name")
 
 		defaultFlag, _ := cmd.Flags().GetBool("default")
 
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		// Resolve --project-id or --project from ProjectsList, IDs being used as is
+		projectIDSelector, _ := cmd.Flags().GetString("project")
+		if looksLikeID(projectIDSelector) {
+			projectID = projectIDSelector
+		} else if projectIDSelector != "" || projectID != "" && !looksLikeID(projectID) {
+			projectIDList, err := client.ProjectsList(ctx)
+			if err == nil {
+				projectIDItems := make([]resolveItem, len(projectIDList))
+				for i, item := range projectIDList {
+					projectIDItems[i].ID = item.ID
+					projectIDItems[i].Names = []string{item.Name}
+					projectIDItems[i].CreatedAt = item.CreatedAt
+				}
+				if projectIDSelector != "" {
+					projectID, err = resolveID("project", projectIDSelector, projectIDItems, false)
+				} else {
+					projectID, err = resolveName("project", projectID, projectIDItems, false)
+				}
+			} else if projectIDSelector == "" {
+				// --project-id goes to the API as given when it can't be listed
+				err = nil
+			}
+			if err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+		}
+
 		params := scalingo.ProjectUpdateParams{
 			Name:    &nameFlag,
 			Default: &defaultFlag,
@@ -151,16 +209,16 @@ var projectsProjectUpdateCmd = &cobra.Command{
 
 		result, err := client.ProjectUpdate(ctx, projectID, params)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -168,52 +226,91 @@ var projectsProjectUpdateCmd = &cobra.Command{
 
 func initprojectsProjectUpdateCmd() {
 
-	projectsProjectUpdateCmd.Flags().String("project-i-d", "", "projectID parameter")
+	projectsProjectUpdateCmd.Flags().String("project-id", "", "Project ID, name or unique prefix")
+
+	projectsProjectUpdateCmd.Flags().String("project", "", "Project to use instead of --project-id: latest, or its ID, name or unique prefix")
 
 	projectsProjectUpdateCmd.Flags().String("name", "", "Name field")
 
 	projectsProjectUpdateCmd.Flags().Bool("default", false, "Default field")
 
-	projectsProjectUpdateCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
+	projectsProjectUpdateCmd.MarkFlagsMutuallyExclusive("project-id", "project")
+
 }
 
 var projectsProjectGetCmd = &cobra.Command{
 	Use:   "project-get",
 	Short: "Projects project-get",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["projects project-get"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		projectID, _ := cmd.Flags().GetString("project-id")
 
-		projectID, _ := cmd.Flags().GetString("project-i-d")
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		// Resolve --project-id or --project from ProjectsList, IDs being used as is
+		projectIDSelector, _ := cmd.Flags().GetString("project")
+		if looksLikeID(projectIDSelector) {
+			projectID = projectIDSelector
+		} else if projectIDSelector != "" || projectID != "" && !looksLikeID(projectID) {
+			projectIDList, err := client.ProjectsList(ctx)
+			if err == nil {
+				projectIDItems := make([]resolveItem, len(projectIDList))
+				for i, item := range projectIDList {
+					projectIDItems[i].ID = item.ID
+					projectIDItems[i].Names = []string{item.Name}
+					projectIDItems[i].CreatedAt = item.CreatedAt
+				}
+				if projectIDSelector != "" {
+					projectID, err = resolveID("project", projectIDSelector, projectIDItems, false)
+				} else {
+					projectID, err = resolveName("project", projectID, projectIDItems, false)
+				}
+			} else if projectIDSelector == "" {
+				// --project-id goes to the API as given when it can't be listed
+				err = nil
+			}
+			if err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+		}
 
 		result, err := client.ProjectGet(ctx, projectID)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -221,39 +318,74 @@ var projectsProjectGetCmd = &cobra.Command{
 
 func initprojectsProjectGetCmd() {
 
-	projectsProjectGetCmd.Flags().String("project-i-d", "", "projectID parameter")
+	projectsProjectGetCmd.Flags().String("project-id", "", "Project ID, name or unique prefix")
+
+	projectsProjectGetCmd.Flags().String("project", "", "Project to use instead of --project-id: latest, or its ID, name or unique prefix")
+
+	projectsProjectGetCmd.MarkFlagsMutuallyExclusive("project-id", "project")
 
-	projectsProjectGetCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var projectsProjectDeleteCmd = &cobra.Command{
 	Use:   "project-delete",
 	Short: "Projects project-delete",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["projects project-delete"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		projectID, _ := cmd.Flags().GetString("project-id")
+
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		projectID, _ := cmd.Flags().GetString("project-i-d")
+		// Resolve --project-id or --project from ProjectsList, IDs being used as is
+		projectIDSelector, _ := cmd.Flags().GetString("project")
+		if looksLikeID(projectIDSelector) {
+			projectID = projectIDSelector
+		} else if projectIDSelector != "" || projectID != "" && !looksLikeID(projectID) {
+			projectIDList, err := client.ProjectsList(ctx)
+			if err == nil {
+				projectIDItems := make([]resolveItem, len(projectIDList))
+				for i, item := range projectIDList {
+					projectIDItems[i].ID = item.ID
+					projectIDItems[i].Names = []string{item.Name}
+					projectIDItems[i].CreatedAt = item.CreatedAt
+				}
+				if projectIDSelector != "" {
+					projectID, err = resolveID("project", projectIDSelector, projectIDItems, true)
+				} else {
+					projectID, err = resolveName("project", projectID, projectIDItems, true)
+				}
+			} else if projectIDSelector == "" {
+				// --project-id goes to the API as given when it can't be listed
+				err = nil
+			}
+			if err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+		}
 
 		if err := client.ProjectDelete(ctx, projectID); err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(render.RenderSuccess("project-delete completed successfully"))
+		fmt.Fprintln(cmd.OutOrStdout(), render.RenderSuccess("project-delete completed successfully"))
 
 		return nil
 	},
@@ -261,48 +393,87 @@ var projectsProjectDeleteCmd = &cobra.Command{
 
 func initprojectsProjectDeleteCmd() {
 
-	projectsProjectDeleteCmd.Flags().String("project-i-d", "", "projectID parameter")
+	projectsProjectDeleteCmd.Flags().String("project-id", "", "Project ID or name")
+
+	projectsProjectDeleteCmd.Flags().String("project", "", "Project to use instead of --project-id: latest, or its ID or name")
+
+	projectsProjectDeleteCmd.MarkFlagsMutuallyExclusive("project-id", "project")
 
-	projectsProjectDeleteCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 var projectsProjectPrivateNetworkGetCmd = &cobra.Command{
 	Use:   "project-private-network-get",
 	Short: "Projects project-private-network-get",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
+
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["projects project-private-network-get"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		authToken, err := config.C.LoadAuth()
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		projectID, _ := cmd.Flags().GetString("project-id")
 
-		projectID, _ := cmd.Flags().GetString("project-i-d")
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
+		// Resolve --project-id or --project from ProjectsList, IDs being used as is
+		projectIDSelector, _ := cmd.Flags().GetString("project")
+		if looksLikeID(projectIDSelector) {
+			projectID = projectIDSelector
+		} else if projectIDSelector != "" || projectID != "" && !looksLikeID(projectID) {
+			projectIDList, err := client.ProjectsList(ctx)
+			if err == nil {
+				projectIDItems := make([]resolveItem, len(projectIDList))
+				for i, item := range projectIDList {
+					projectIDItems[i].ID = item.ID
+					projectIDItems[i].Names = []string{item.Name}
+					projectIDItems[i].CreatedAt = item.CreatedAt
+				}
+				if projectIDSelector != "" {
+					projectID, err = resolveID("project", projectIDSelector, projectIDItems, false)
+				} else {
+					projectID, err = resolveName("project", projectID, projectIDItems, false)
+				}
+			} else if projectIDSelector == "" {
+				// --project-id goes to the API as given when it can't be listed
+				err = nil
+			}
+			if err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+		}
 
 		result, err := client.ProjectPrivateNetworkGet(ctx, projectID)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -310,9 +481,12 @@ var projectsProjectPrivateNetworkGetCmd = &cobra.Command{
 
 func initprojectsProjectPrivateNetworkGetCmd() {
 
-	projectsProjectPrivateNetworkGetCmd.Flags().String("project-i-d", "", "projectID parameter")
+	projectsProjectPrivateNetworkGetCmd.Flags().String("project-id", "", "Project ID, name or unique prefix")
+
+	projectsProjectPrivateNetworkGetCmd.Flags().String("project", "", "Project to use instead of --project-id: latest, or its ID, name or unique prefix")
+
+	projectsProjectPrivateNetworkGetCmd.MarkFlagsMutuallyExclusive("project-id", "project")
 
-	projectsProjectPrivateNetworkGetCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 // RegisterProjectsServiceCommands registers all generated commands with the parent
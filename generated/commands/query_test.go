@@ -0,0 +1,52 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"generative-cli/config"
+)
+
+func TestOutputTemplateFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.tmpl")
+	if err := os.WriteFile(path, []byte(`{{range .}}{{.ID}},{{end}}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	fake := useFakeClient(t)
+	resetFlags(t, []string{"events", "list"}, "page", "per-page", "limit", "all")
+	newClient = eventsClientFor(fake)
+	config.C.TemplateFileFlag = path
+
+	out := runCommand(t, "events", "list", "--all")
+
+	assertOutput(t, out, "event-1,event-2,\n")
+}
+
+func TestOutputInvalidTemplates(t *testing.T) {
+	tests := []struct {
+		output, query, want string
+	}{
+		{output: "jsonpath", want: "output format jsonpath needs a template"},
+		{output: "jsonpath={.items[*}", want: "invalid jsonpath"},
+		{output: "go-template={{.ID", want: "invalid go-template"},
+		{output: "table", query: ".[] | select(", want: "invalid query"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.output+tt.query, func(t *testing.T) {
+			fake := useFakeClient(t)
+			config.C.OutputFlag = tt.output
+			config.C.QueryFlag = tt.query
+
+			testRoot.SetArgs([]string{"apps", "list"})
+			err := testRoot.Execute()
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("error = %v, want %q", err, tt.want)
+			}
+			if len(fake.calls) > 0 {
+				t.Errorf("SDK called despite the invalid template: %v", fake.calls)
+			}
+		})
+	}
+}
@@ -3,40 +3,43 @@ package commands
 
 import "github.com/spf13/cobra"
 
-// RegisterAll registers all generated service commands with the parent command
+// RegisterAll registers all generated service commands with the parent command,
+// along with the hand-written commands attached with AttachCommand
 func RegisterAll(parent *cobra.Command) {
-	RegisterDatabasesServiceCommands(parent)
-	RegisterRegionsServiceCommands(parent)
-	RegisterOperationsServiceCommands(parent)
-	RegisterStacksServiceCommands(parent)
-	RegisterLogDrainsServiceCommands(parent)
-	RegisterContainersServiceCommands(parent)
-	RegisterNotifiersServiceCommands(parent)
-	RegisterCollaboratorsServiceCommands(parent)
-	RegisterLogsArchivesServiceCommands(parent)
-	RegisterInvoicesServiceCommands(parent)
+	RegisterAddonProvidersServiceCommands(parent)
+	RegisterAddonsServiceCommands(parent)
+	RegisterAlertsServiceCommands(parent)
 	RegisterAppsServiceCommands(parent)
-	RegisterVariablesServiceCommands(parent)
-	RegisterRunsServiceCommands(parent)
-	RegisterContainerSizesServiceCommands(parent)
 	RegisterAutoscalersServiceCommands(parent)
-	RegisterAddonProvidersServiceCommands(parent)
-	RegisterCronTasksServiceCommands(parent)
-	RegisterTokensServiceCommands(parent)
 	RegisterBackupsServiceCommands(parent)
-	RegisterSCMIntegrationsServiceCommands(parent)
-	RegisterAlertsServiceCommands(parent)
+	RegisterCollaboratorsServiceCommands(parent)
+	RegisterContainerSizesServiceCommands(parent)
+	RegisterContainersServiceCommands(parent)
+	RegisterCronTasksServiceCommands(parent)
+	RegisterDatabasesServiceCommands(parent)
 	RegisterDeploymentsServiceCommands(parent)
-	RegisterProjectsServiceCommands(parent)
+	RegisterDomainsServiceCommands(parent)
+	RegisterEventsServiceCommands(parent)
+	RegisterInvoicesServiceCommands(parent)
+	RegisterKeysServiceCommands(parent)
+	RegisterLogDrainsServiceCommands(parent)
+	RegisterLogsArchivesServiceCommands(parent)
+	RegisterLogsServiceCommands(parent)
 	RegisterNotificationPlatformsServiceCommands(parent)
+	RegisterNotifiersServiceCommands(parent)
+	RegisterOperationsServiceCommands(parent)
 	RegisterPrivateNetworksServiceCommands(parent)
+	RegisterProjectsServiceCommands(parent)
+	RegisterRegionsServiceCommands(parent)
+	RegisterRunsServiceCommands(parent)
+	RegisterSCMIntegrationsServiceCommands(parent)
 	RegisterSCMRepoLinkServiceCommands(parent)
-	RegisterEventsServiceCommands(parent)
-	RegisterAddonsServiceCommands(parent)
 	RegisterSignUpServiceCommands(parent)
-	RegisterDomainsServiceCommands(parent)
-	RegisterUsersServiceCommands(parent)
 	RegisterSourcesServiceCommands(parent)
-	RegisterLogsServiceCommands(parent)
-	RegisterKeysServiceCommands(parent)
+	RegisterStacksServiceCommands(parent)
+	RegisterTokensServiceCommands(parent)
+	RegisterUsersServiceCommands(parent)
+	RegisterVariablesServiceCommands(parent)
+
+	attachCommands(parent)
 }
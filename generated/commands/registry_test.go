@@ -0,0 +1,114 @@
+package commands
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+// useAttachedCommands replaces the commands registered with AttachCommand for the duration of the test
+func useAttachedCommands(t *testing.T) {
+	t.Helper()
+	prev := attachedCommands
+	attachedCommands = nil
+	t.Cleanup(func() { attachedCommands = prev })
+}
+
+// assertPanics checks that f panics
+func assertPanics(t *testing.T, name string, f func()) {
+	t.Helper()
+	defer func() {
+		if recover() == nil {
+			t.Errorf("%s did not panic", name)
+		}
+	}()
+	f()
+}
+
+func TestReplaceCommand(t *testing.T) {
+	customKeys["deployments create"] = true
+	t.Cleanup(func() {
+		delete(customKeys, "deployments create")
+		delete(replacedCommands, "deployments create")
+	})
+
+	stub := &cobra.Command{Use: "create"}
+	if got := replacedCommand("deployments create", stub); got != stub {
+		t.Errorf("replacedCommand returned %v before ReplaceCommand, want the stub", got)
+	}
+
+	custom := &cobra.Command{Use: "create"}
+	ReplaceCommand("deployments create", custom)
+	if got := replacedCommand("deployments create", stub); got != custom {
+		t.Errorf("replacedCommand returned %v, want the custom command", got)
+	}
+
+	assertPanics(t, "ReplaceCommand twice", func() { ReplaceCommand("deployments create", custom) })
+	assertPanics(t, "ReplaceCommand of a generated command", func() { ReplaceCommand("apps list", custom) })
+}
+
+func TestAttachCommand(t *testing.T) {
+	useAttachedCommands(t)
+
+	var ran []string
+	newCmd := func(use string) *cobra.Command {
+		return &cobra.Command{Use: use, Run: func(cmd *cobra.Command, args []string) {
+			ran = append(ran, cmd.CommandPath())
+		}}
+	}
+	root := &cobra.Command{Use: "test"}
+	apps := &cobra.Command{Use: "apps"}
+	apps.AddCommand(&cobra.Command{Use: "list"})
+	root.AddCommand(apps)
+
+	AttachCommand("", newCmd("deploy"))
+	AttachCommand("apps", newCmd("open"))
+	AttachCommand("apps list", newCmd("mine"))
+	attachCommands(root)
+
+	for _, args := range [][]string{{"deploy"}, {"apps", "open"}, {"apps", "list", "mine"}} {
+		root.SetArgs(args)
+		root.SetOut(&bytes.Buffer{})
+		if err := root.Execute(); err != nil {
+			t.Fatalf("%v: %v", args, err)
+		}
+	}
+	want := []string{"test deploy", "test apps open", "test apps list mine"}
+	if len(ran) != len(want) {
+		t.Fatalf("ran %v, want %v", ran, want)
+	}
+	for i := range want {
+		if ran[i] != want[i] {
+			t.Errorf("ran %v, want %v", ran, want)
+		}
+	}
+
+	attachedCommands = []attachedCommand{{"apps", newCmd("list")}}
+	assertPanics(t, "attaching over a generated command", func() { attachCommands(root) })
+	attachedCommands = []attachedCommand{{"apps missing", newCmd("x")}}
+	assertPanics(t, "attaching below a missing parent", func() { attachCommands(root) })
+}
+
+func TestLegacyCommandAliases(t *testing.T) {
+	if testRoot == nil {
+		testRoot = &cobra.Command{Use: "test", SilenceUsage: true, SilenceErrors: true}
+		RegisterAll(testRoot)
+	}
+
+	tests := map[string][]string{
+		"scm-integrations list": {"s_c_m_integrations", "list"},
+		"cron-tasks get":        {"cron_tasks", "get"},
+		"apps force-https":      {"apps", "force-h-t-t-p-s"},
+	}
+	for want, args := range tests {
+		cmd, _, err := testRoot.Find(args)
+		if err != nil {
+			t.Errorf("%v: %v", args, err)
+			continue
+		}
+		if got := cmd.Parent().Name() + " " + cmd.Name(); got != want {
+			t.Errorf("%v found %q, want %q", args, got, want)
+		}
+	}
+}
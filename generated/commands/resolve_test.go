@@ -0,0 +1,223 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	scalingo "github.com/Scalingo/go-scalingo/v8"
+	"github.com/spf13/cobra"
+)
+
+func TestResolveID(t *testing.T) {
+	day := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+	items := []resolveItem{
+		{ID: "d-1", CreatedAt: day},
+		{ID: "d-2", CreatedAt: day.Add(time.Hour)},
+		{ID: "d-3", CreatedAt: day.Add(-time.Hour)},
+	}
+
+	tests := []struct {
+		name     string
+		selector string
+		items    []resolveItem
+		exact    bool
+		want     string
+		wantErr  string
+	}{
+		{name: "latest", selector: "latest", items: items, want: "d-2"},
+		{name: "latest without dates", selector: "latest", items: []resolveItem{{ID: "a"}, {ID: "b"}}, want: "a"},
+		{name: "ID", selector: "d-3", items: items, want: "d-3"},
+		{name: "prefix", selector: "d-", items: []resolveItem{{ID: "d-1"}, {ID: "e-1"}}, want: "d-1"},
+		{name: "exact prefix", selector: "d-", items: []resolveItem{{ID: "d-1"}, {ID: "e-1"}}, exact: true, wantErr: `no deployment "d-" found (use latest, an ID or a name)`},
+		{name: "unknown ID", selector: "d-4", items: items, wantErr: `no deployment "d-4" found (use latest, an ID, a name or a unique prefix)`},
+		{name: "empty list", selector: "latest", wantErr: "no deployment found"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveID("deployment", tt.selector, tt.items, tt.exact)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("resolveID() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveName(t *testing.T) {
+	items := []resolveItem{
+		{ID: "k-1", Names: []string{"laptop"}},
+		{ID: "k-2", Names: []string{"laptop-old"}},
+		{ID: "k-3", Names: []string{"desktop"}},
+	}
+
+	tests := []struct {
+		name    string
+		value   string
+		exact   bool
+		want    string
+		wantErr string
+	}{
+		{name: "ID", value: "k-2", want: "k-2"},
+		{name: "exact name over prefix", value: "laptop", want: "k-1"},
+		{name: "unique name prefix", value: "desk", want: "k-3"},
+		{name: "exact name", value: "laptop", exact: true, want: "k-1"},
+		{name: "exact prefix", value: "desk", exact: true, want: "desk"},
+		{name: "exact ambiguous prefix", value: "lap", exact: true, want: "lap"},
+		{name: "unknown value", value: "server", want: "server"},
+		{name: "ambiguous prefix", value: "lap", wantErr: "\"lap\" matches 2 keys, use one of:\n  k-1 (laptop)\n  k-2 (laptop-old)"},
+		{name: "ambiguous ID prefix", value: "k-", wantErr: "\"k-\" matches 3 keys"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveName("key", tt.value, items, tt.exact)
+			if tt.wantErr != "" {
+				if err == nil || !strings.HasPrefix(err.Error(), tt.wantErr) {
+					t.Fatalf("error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("resolveName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// deploymentsClient lists the given deployments
+type deploymentsClient struct {
+	*fakeClient
+	deployments []*scalingo.Deployment
+}
+
+func (c deploymentsClient) DeploymentList(ctx context.Context, app string) ([]*scalingo.Deployment, error) {
+	c.record("DeploymentList", []any{app}, c.deployments)
+	return c.deployments, nil
+}
+
+// resetFlags clears the given flags of the command at path, before and after
+// the test, as the test command tree is shared
+func resetFlags(t *testing.T, path []string, flags ...string) {
+	t.Helper()
+	if testRoot == nil {
+		testRoot = &cobra.Command{Use: "test", SilenceUsage: true, SilenceErrors: true}
+		RegisterAll(testRoot)
+	}
+	cmd, _, err := testRoot.Find(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reset := func() {
+		for _, name := range flags {
+			flag := cmd.Flags().Lookup(name)
+			if err := flag.Value.Set(flag.DefValue); err != nil {
+				t.Fatal(err)
+			}
+			flag.Changed = false
+		}
+	}
+	reset()
+	t.Cleanup(reset)
+}
+
+func TestDeploymentSelector(t *testing.T) {
+	for _, tt := range []struct {
+		selector string
+		listed   bool
+		want     string
+	}{
+		{selector: "latest", listed: true, want: "deploy-1"},
+		{selector: "deploy-2", listed: true, want: "deploy-2"},
+		{selector: "0b5c7e2a-1d3f-4a6b-8c9d-0e1f2a3b4c5d", want: "0b5c7e2a-1d3f-4a6b-8c9d-0e1f2a3b4c5d"},
+	} {
+		t.Run(tt.selector, func(t *testing.T) {
+			fake := useFakeClient(t)
+			newClient = func(ctx context.Context) (Client, error) {
+				return deploymentsClient{fake, []*scalingo.Deployment{{ID: "deploy-1"}, {ID: "deploy-2"}}}, nil
+			}
+			resetFlags(t, []string{"deployments", "deployment"}, "deploy", "deployment")
+
+			runCommand(t, "deployments", "deployment", "--deployment="+tt.selector)
+
+			if listed := len(fake.callsTo("DeploymentList")) == 1; listed != tt.listed {
+				t.Errorf("expected DeploymentList called %v, got calls %v", tt.listed, fake.calls)
+			}
+			fake.expectCall(t, "Deployment", []any{testApp, tt.want})
+		})
+	}
+}
+
+// keysClient lists the given keys, or fails with err
+type keysClient struct {
+	*fakeClient
+	keys []scalingo.Key
+	err  error
+}
+
+func (c keysClient) KeysList(ctx context.Context) ([]scalingo.Key, error) {
+	c.record("KeysList", []any{}, c.keys)
+	return c.keys, c.err
+}
+
+func TestKeyNameResolution(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		value   string
+		listErr error
+		listed  bool
+		want    string
+	}{
+		{name: "name", value: "laptop", listed: true, want: "key-1"},
+		{name: "prefix on delete", value: "desk", listed: true, want: "desk"},
+		{name: "unknown", value: "key-3", listed: true, want: "key-3"},
+		{name: "object ID", value: "5f0c1a2b3c4d5e6f7a8b9c0d", want: "5f0c1a2b3c4d5e6f7a8b9c0d"},
+		{name: "UUID", value: "ky-0b5c7e2a-1d3f-4a6b-8c9d-0e1f2a3b4c5d", want: "ky-0b5c7e2a-1d3f-4a6b-8c9d-0e1f2a3b4c5d"},
+		{name: "list failure", value: "laptop", listErr: errors.New("forbidden"), listed: true, want: "laptop"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := useFakeClient(t)
+			newClient = func(ctx context.Context) (Client, error) {
+				return keysClient{fake, []scalingo.Key{{ID: "key-1", Name: "laptop"}, {ID: "key-2", Name: "desktop"}}, tt.listErr}, nil
+			}
+			resetFlags(t, []string{"keys", "delete"}, "id", "key")
+
+			runCommand(t, "keys", "delete", "--id="+tt.value)
+
+			if listed := len(fake.callsTo("KeysList")) == 1; listed != tt.listed {
+				t.Errorf("expected KeysList called %v, got calls %v", tt.listed, fake.calls)
+			}
+			fake.expectCall(t, "KeysDelete", []any{tt.want})
+		})
+	}
+}
+
+func TestKeySelectorListFailure(t *testing.T) {
+	fake := useFakeClient(t)
+	newClient = func(ctx context.Context) (Client, error) {
+		return keysClient{fake, nil, errors.New("forbidden")}, nil
+	}
+	resetFlags(t, []string{"keys", "delete"}, "id", "key")
+
+	testRoot.SetArgs([]string{"keys", "delete", "--key=laptop"})
+	err := testRoot.ExecuteContext(context.Background())
+
+	if err == nil || err.Error() != "forbidden" {
+		t.Errorf("error = %v, want forbidden", err)
+	}
+	if calls := fake.callsTo("KeysDelete"); len(calls) != 0 {
+		t.Errorf("expected no KeysDelete call, got %v", calls)
+	}
+}
@@ -0,0 +1,113 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	scalingo "github.com/Scalingo/go-scalingo/v8"
+	"github.com/spf13/cobra"
+
+	"generative-cli/config"
+	"generative-cli/render"
+)
+
+// variableSetClient sets variables, returning the given status code
+type variableSetClient struct {
+	*fakeClient
+	status int
+}
+
+func (c variableSetClient) VariableSet(ctx context.Context, app, name, value string) (*scalingo.Variable, int, error) {
+	variable := &scalingo.Variable{ID: "var-1", Name: name}
+	c.record("VariableSet", []any{app, name, value}, variable, c.status)
+	return variable, c.status, nil
+}
+
+// runVariableSet runs variables variable-set in format, the client returning status
+func runVariableSet(t *testing.T, format render.OutputFormat, status int) string {
+	t.Helper()
+	fake := useFakeClient(t)
+	newClient = func(ctx context.Context) (Client, error) {
+		return variableSetClient{fake, status}, nil
+	}
+	config.C.OutputFlag = string(format)
+	return runCommand(t, "variables", "variable-set", "--name=KEY", "--value=v")
+}
+
+func TestResultsJSONEnvelope(t *testing.T) {
+	out := runVariableSet(t, render.FormatJSON, 201)
+
+	var got struct {
+		Variable scalingo.Variable `json:"variable"`
+		Status   int               `json:"status"`
+	}
+	if err := json.Unmarshal([]byte(out), &got); err != nil {
+		t.Fatalf("decode %s: %v", out, err)
+	}
+	if got.Variable.ID != "var-1" || got.Variable.Name != "KEY" || got.Status != 201 {
+		t.Errorf("envelope = %+v", got)
+	}
+	if !strings.HasPrefix(out, "{\n  \"variable\": {") {
+		t.Errorf("results out of order:\n%s", out)
+	}
+}
+
+func TestResultsNDJSONEnvelope(t *testing.T) {
+	out := runVariableSet(t, render.FormatNDJSON, 200)
+
+	if strings.Count(out, "\n") != 1 || !strings.HasSuffix(out, `,"status":200}`+"\n") {
+		t.Errorf("output is not a single envelope line:\n%s", out)
+	}
+}
+
+func TestResultsTable(t *testing.T) {
+	out := runVariableSet(t, render.FormatTable, 201)
+
+	variable, err := render.RenderResult(&scalingo.Variable{ID: "var-1", Name: "KEY"}, render.FormatTable)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertOutput(t, out, variable+"\n"+render.KeyStyle.Render("status")+render.ValueStyle.Render("201")+"\n")
+}
+
+func TestResultsAfterCall(t *testing.T) {
+	useHooks(t, "variables variable-set", Hooks{
+		AfterCall: func(cmd *cobra.Command, result any) (any, error) {
+			// Keep the status only
+			return result.(render.Results)[1].Value, nil
+		},
+	})
+	out := runVariableSet(t, render.FormatJSON, 201)
+
+	assertOutput(t, out, "201\n")
+}
+
+func TestPaginationEnvelope(t *testing.T) {
+	useFakeClient(t)
+	resetFlags(t, paginatedCommand, "page", "per-page", "limit", "all")
+	cmd, _, err := testRoot.Find(paginatedCommand)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out strings.Builder
+	cmd.SetOut(&out)
+	t.Cleanup(func() { cmd.SetOut(nil) })
+
+	fetch := func(opts scalingo.PaginationOpts) ([]*scalingo.Deployment, scalingo.PaginationMeta, error) {
+		return []*scalingo.Deployment{{ID: "deploy-1"}}, scalingo.PaginationMeta{CurrentPage: opts.Page, TotalPages: 1}, nil
+	}
+	if err := paginate(cmd, nil, render.FormatNDJSON, fetch, "deployments", "meta"); err != nil {
+		t.Fatal(err)
+	}
+
+	want, err := render.RenderResult(render.Results{
+		{Name: "deployments", Value: []*scalingo.Deployment{{ID: "deploy-1"}}},
+		{Name: "meta", Value: scalingo.PaginationMeta{CurrentPage: 1, TotalPages: 1}},
+	}, render.FormatNDJSON)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertOutput(t, out.String(), want+"\n")
+}
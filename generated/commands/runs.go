@@ -2,7 +2,6 @@
 package commands
 
 import (
-	"context"
 	"fmt"
 
 	scalingo "github.com/Scalingo/go-scalingo/v8"
@@ -16,26 +15,33 @@ var runsRunCmd = &cobra.Command{
 	Use:   "run",
 	Short: "Runs run",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["runs run"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		outputFormat, _ := cmd.Flags().GetString("output")
+		client, err := newClient(ctx)
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
-		appFlag, _ := cmd.Flags().GetString("app")
+		appFlag, err := config.C.RequireApp()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
 
 		commandFlag, _ := cmd.Flags().GetStringSlice("command")
 
@@ -45,6 +51,11 @@ var runsRunCmd = &cobra.Command{
 
 		hasUploadsFlag, _ := cmd.Flags().GetBool("has-uploads")
 
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
 		opts := scalingo.RunOpts{
 			App:        appFlag,
 			Command:    commandFlag,
@@ -55,16 +66,16 @@ var runsRunCmd = &cobra.Command{
 
 		result, err := client.Run(ctx, opts)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 
 		return nil
 	},
@@ -72,8 +83,6 @@ var runsRunCmd = &cobra.Command{
 
 func initrunsRunCmd() {
 
-	runsRunCmd.Flags().String("app", "", "App field")
-
 	runsRunCmd.Flags().StringSlice("command", nil, "Command field")
 
 	runsRunCmd.Flags().String("size", "", "Size field")
@@ -82,7 +91,6 @@ func initrunsRunCmd() {
 
 	runsRunCmd.Flags().Bool("has-uploads", false, "HasUploads field")
 
-	runsRunCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 // RegisterRunsServiceCommands registers all generated commands with the parent
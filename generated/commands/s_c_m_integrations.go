@@ -1,279 +0,0 @@
-// Code generated by generative-cli. DO NOT EDIT.
-package commands
-
-import (
-	"context"
-	"fmt"
-
-	scalingo "github.com/Scalingo/go-scalingo/v8"
-	"github.com/spf13/cobra"
-
-	"generative-cli/config"
-	"generative-cli/render"
-)
-
-var sCMIntegrationsListCmd = &cobra.Command{
-	Use:   "list",
-	Short: "SCMIntegrations list",
-	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
-
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
-			return err
-		}
-
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
-		if err != nil {
-			fmt.Println(render.RenderError(err))
-			return err
-		}
-
-		outputFormat, _ := cmd.Flags().GetString("output")
-
-		result, err := client.SCMIntegrationsList(ctx)
-		if err != nil {
-			fmt.Println(render.RenderError(err))
-			return err
-		}
-
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
-		if err != nil {
-			fmt.Println(render.RenderError(err))
-			return err
-		}
-		fmt.Println(output)
-
-		return nil
-	},
-}
-
-func initsCMIntegrationsListCmd() {
-
-	sCMIntegrationsListCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
-}
-
-var sCMIntegrationsShowCmd = &cobra.Command{
-	Use:   "show",
-	Short: "SCMIntegrations show",
-	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
-
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
-			return err
-		}
-
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
-		if err != nil {
-			fmt.Println(render.RenderError(err))
-			return err
-		}
-
-		outputFormat, _ := cmd.Flags().GetString("output")
-
-		id, _ := cmd.Flags().GetString("id")
-
-		result, err := client.SCMIntegrationsShow(ctx, id)
-		if err != nil {
-			fmt.Println(render.RenderError(err))
-			return err
-		}
-
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
-		if err != nil {
-			fmt.Println(render.RenderError(err))
-			return err
-		}
-		fmt.Println(output)
-
-		return nil
-	},
-}
-
-func initsCMIntegrationsShowCmd() {
-
-	sCMIntegrationsShowCmd.Flags().StringP("id", "i", "", "id parameter")
-
-	sCMIntegrationsShowCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
-}
-
-var sCMIntegrationsCreateCmd = &cobra.Command{
-	Use:   "create",
-	Short: "SCMIntegrations create",
-	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
-
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
-			return err
-		}
-
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
-		if err != nil {
-			fmt.Println(render.RenderError(err))
-			return err
-		}
-
-		outputFormat, _ := cmd.Flags().GetString("output")
-
-		scmTypeRaw, _ := cmd.Flags().GetString("scm-type")
-		scmType := scalingo.SCMType(scmTypeRaw)
-
-		url, _ := cmd.Flags().GetString("url")
-
-		accessToken, _ := cmd.Flags().GetString("access-token")
-
-		result, err := client.SCMIntegrationsCreate(ctx, scmType, url, accessToken)
-		if err != nil {
-			fmt.Println(render.RenderError(err))
-			return err
-		}
-
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
-		if err != nil {
-			fmt.Println(render.RenderError(err))
-			return err
-		}
-		fmt.Println(output)
-
-		return nil
-	},
-}
-
-func initsCMIntegrationsCreateCmd() {
-
-	sCMIntegrationsCreateCmd.Flags().String("scm-type", "", "scmType (JSON format)")
-
-	sCMIntegrationsCreateCmd.Flags().String("url", "", "url parameter")
-
-	sCMIntegrationsCreateCmd.Flags().String("access-token", "", "accessToken parameter")
-
-	sCMIntegrationsCreateCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
-}
-
-var sCMIntegrationsDeleteCmd = &cobra.Command{
-	Use:   "delete",
-	Short: "SCMIntegrations delete",
-	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
-
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
-			return err
-		}
-
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
-		if err != nil {
-			fmt.Println(render.RenderError(err))
-			return err
-		}
-
-		id, _ := cmd.Flags().GetString("id")
-
-		if err := client.SCMIntegrationsDelete(ctx, id); err != nil {
-			fmt.Println(render.RenderError(err))
-			return err
-		}
-		fmt.Println(render.RenderSuccess("delete completed successfully"))
-
-		return nil
-	},
-}
-
-func initsCMIntegrationsDeleteCmd() {
-
-	sCMIntegrationsDeleteCmd.Flags().StringP("id", "i", "", "id parameter")
-
-	sCMIntegrationsDeleteCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
-}
-
-var sCMIntegrationsImportKeysCmd = &cobra.Command{
-	Use:   "import-keys",
-	Short: "SCMIntegrations import-keys",
-	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
-
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
-			return err
-		}
-
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
-		if err != nil {
-			fmt.Println(render.RenderError(err))
-			return err
-		}
-
-		outputFormat, _ := cmd.Flags().GetString("output")
-
-		id, _ := cmd.Flags().GetString("id")
-
-		result, err := client.SCMIntegrationsImportKeys(ctx, id)
-		if err != nil {
-			fmt.Println(render.RenderError(err))
-			return err
-		}
-
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
-		if err != nil {
-			fmt.Println(render.RenderError(err))
-			return err
-		}
-		fmt.Println(output)
-
-		return nil
-	},
-}
-
-func initsCMIntegrationsImportKeysCmd() {
-
-	sCMIntegrationsImportKeysCmd.Flags().StringP("id", "i", "", "id parameter")
-
-	sCMIntegrationsImportKeysCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
-}
-
-// RegisterSCMIntegrationsServiceCommands registers all generated commands with the parent
-func RegisterSCMIntegrationsServiceCommands(parent *cobra.Command) {
-	serviceCmd := &cobra.Command{
-		Use:   "s_c_m_integrations",
-		Short: "SCMIntegrationsService operations",
-	}
-
-	initsCMIntegrationsListCmd()
-	serviceCmd.AddCommand(sCMIntegrationsListCmd)
-
-	initsCMIntegrationsShowCmd()
-	serviceCmd.AddCommand(sCMIntegrationsShowCmd)
-
-	initsCMIntegrationsCreateCmd()
-	serviceCmd.AddCommand(sCMIntegrationsCreateCmd)
-
-	initsCMIntegrationsDeleteCmd()
-	serviceCmd.AddCommand(sCMIntegrationsDeleteCmd)
-
-	initsCMIntegrationsImportKeysCmd()
-	serviceCmd.AddCommand(sCMIntegrationsImportKeysCmd)
-
-	parent.AddCommand(serviceCmd)
-}
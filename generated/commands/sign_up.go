@@ -2,13 +2,10 @@
 package commands
 
 import (
-	"context"
 	"fmt"
 
-	scalingo "github.com/Scalingo/go-scalingo/v8"
 	"github.com/spf13/cobra"
 
-	"generative-cli/config"
 	"generative-cli/render"
 )
 
@@ -16,20 +13,19 @@ var signUpRunCmd = &cobra.Command{
 	Use:   "run",
 	Short: "SignUp run",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
 
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["sign-up run"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		client, err := newClient(ctx)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
@@ -37,11 +33,16 @@ var signUpRunCmd = &cobra.Command{
 
 		password, _ := cmd.Flags().GetString("password")
 
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+
 		if err := client.SignUp(ctx, email, password); err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(render.RenderSuccess("run completed successfully"))
+		fmt.Fprintln(cmd.OutOrStdout(), render.RenderSuccess("run completed successfully"))
 
 		return nil
 	},
@@ -53,14 +54,14 @@ func initsignUpRunCmd() {
 
 	signUpRunCmd.Flags().String("password", "", "password parameter")
 
-	signUpRunCmd.Flags().StringP("output", "o", "table", "Output format (table, json)")
 }
 
 // RegisterSignUpServiceCommands registers all generated commands with the parent
 func RegisterSignUpServiceCommands(parent *cobra.Command) {
 	serviceCmd := &cobra.Command{
-		Use:   "sign_up",
-		Short: "SignUpService operations",
+		Use:     "sign-up",
+		Aliases: []string{"sign_up"},
+		Short:   "SignUpService operations",
 	}
 
 	initsignUpRunCmd()
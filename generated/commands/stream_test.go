@@ -0,0 +1,270 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/gorilla/websocket"
+
+	"generative-cli/config"
+	"generative-cli/render"
+)
+
+// streamCommand is a websocket command of the test tree
+var streamCommand = []string{"deployments", "deployment-stream"}
+
+// streamClient streams deployments from a local websocket server
+type streamClient struct {
+	*fakeClient
+	url string
+}
+
+func (c streamClient) DeploymentStream(ctx context.Context, deployURL string) (*websocket.Conn, error) {
+	c.record("DeploymentStream", []any{deployURL})
+	conn, _, err := websocket.DefaultDialer.DialContext(ctx, c.url, nil)
+	return conn, err
+}
+
+// useStreamServer makes DeploymentStream connect to a local websocket server
+// calling serve with each connection, numbered from 1
+func useStreamServer(t *testing.T, serve func(conn *websocket.Conn, connection int)) *fakeClient {
+	t.Helper()
+	fake := useFakeClient(t)
+	var connections atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		serve(conn, int(connections.Add(1)))
+	}))
+	t.Cleanup(server.Close)
+
+	newClient = func(ctx context.Context) (Client, error) {
+		return streamClient{fake, "ws" + strings.TrimPrefix(server.URL, "http")}, nil
+	}
+	prevDelay := reconnectDelay
+	reconnectDelay = 0
+	t.Cleanup(func() { reconnectDelay = prevDelay })
+	resetFlags(t, streamCommand, "deploy-url", "reconnect")
+	return fake
+}
+
+// send writes messages to conn, then closes it normally
+func send(conn *websocket.Conn, messages ...string) {
+	for _, msg := range messages {
+		if err := conn.WriteMessage(websocket.TextMessage, []byte(msg)); err != nil {
+			return
+		}
+	}
+	conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+}
+
+// runStream runs args, returning stdout, stderr and the command error
+func runStream(t *testing.T, args ...string) (string, string, error) {
+	t.Helper()
+	var out, errOut bytes.Buffer
+	testRoot.SetOut(&out)
+	testRoot.SetErr(&errOut)
+	t.Cleanup(func() { testRoot.SetErr(nil) })
+	testRoot.SetArgs(args)
+	err := testRoot.ExecuteContext(context.Background())
+	return out.String(), errOut.String(), err
+}
+
+func TestStreamEvents(t *testing.T) {
+	useStreamServer(t, func(conn *websocket.Conn, _ int) {
+		send(conn,
+			`{"type": "log", "data": {"content": "Building\n"}}`,
+			`{"type": "status", "data": {"status": "pushing"}}`,
+			`plain text`,
+		)
+	})
+
+	out, _, err := runStream(t, streamCommand...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertOutput(t, out, "Building\n"+render.RenderInfo("Status: pushing")+"\nplain text\n")
+}
+
+func TestStreamNDJSON(t *testing.T) {
+	useStreamServer(t, func(conn *websocket.Conn, _ int) {
+		send(conn, `{"type": "log", "data": {"content": "a"}}`, `text`)
+	})
+	config.C.OutputFlag = string(render.FormatNDJSON)
+
+	out, _, err := runStream(t, streamCommand...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertOutput(t, out, `{"type":"log","data":{"content":"a"}}`+"\n"+`"text"`+"\n")
+}
+
+func TestStreamFinalStatus(t *testing.T) {
+	for _, tt := range []struct {
+		status  string
+		wantErr string
+	}{
+		{status: "success"},
+		{status: "build-error", wantErr: "stream ended with status build-error"},
+		{status: "aborted", wantErr: "stream ended with status aborted"},
+	} {
+		t.Run(tt.status, func(t *testing.T) {
+			useStreamServer(t, func(conn *websocket.Conn, _ int) {
+				// Events after a final status are not read
+				send(conn, `{"type": "status", "data": {"status": "`+tt.status+`"}}`, "after")
+			})
+
+			out, _, err := runStream(t, streamCommand...)
+			if tt.wantErr == "" && err != nil {
+				t.Fatal(err)
+			}
+			var statusErr *render.StreamStatusError
+			if tt.wantErr != "" && (!errors.As(err, &statusErr) || err.Error() != tt.wantErr) {
+				t.Fatalf("error = %v, want %q", err, tt.wantErr)
+			}
+			if strings.Contains(out, "after") {
+				t.Errorf("stream read after its final status:\n%s", out)
+			}
+		})
+	}
+}
+
+func TestStreamReconnect(t *testing.T) {
+	fake := useStreamServer(t, func(conn *websocket.Conn, connection int) {
+		if connection == 1 {
+			// Drop the connection without a close message
+			conn.WriteMessage(websocket.TextMessage, []byte("first"))
+			return
+		}
+		send(conn, "second")
+	})
+
+	out, errOut, err := runStream(t, streamCommand...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertOutput(t, out, "first\nsecond\n")
+	if !strings.Contains(errOut, "connecting again in 0s (1/5)") {
+		t.Errorf("reconnection not reported:\n%s", errOut)
+	}
+	if calls := fake.callsTo("DeploymentStream"); len(calls) != 2 {
+		t.Errorf("DeploymentStream called %d times, want 2", len(calls))
+	}
+}
+
+func TestStreamReconnectLimit(t *testing.T) {
+	fake := useStreamServer(t, func(conn *websocket.Conn, _ int) {})
+
+	_, _, err := runStream(t, append(streamCommand, "--reconnect=2")...)
+	if !errors.Is(err, render.ErrStreamDropped) {
+		t.Fatalf("error = %v, want a dropped stream", err)
+	}
+	if calls := fake.callsTo("DeploymentStream"); len(calls) != 3 {
+		t.Errorf("DeploymentStream called %d times, want 3", len(calls))
+	}
+}
+
+// cancelWriter cancels a command once it wrote its first line
+type cancelWriter struct {
+	bytes.Buffer
+	cancel context.CancelFunc
+}
+
+func (w *cancelWriter) Write(p []byte) (int, error) {
+	defer w.cancel()
+	return w.Buffer.Write(p)
+}
+
+func TestStreamCancelledSummary(t *testing.T) {
+	done := make(chan struct{})
+	useStreamServer(t, func(conn *websocket.Conn, _ int) {
+		conn.WriteMessage(websocket.TextMessage, []byte("first"))
+		<-done
+	})
+	t.Cleanup(func() { close(done) })
+
+	// Cobra keeps the context of a subcommand run before, so set it
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cmd, _, err := testRoot.Find(streamCommand)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd.SetContext(ctx)
+	t.Cleanup(func() { cmd.SetContext(context.Background()) })
+	out := &cancelWriter{cancel: cancel}
+	var errOut bytes.Buffer
+	testRoot.SetOut(out)
+	testRoot.SetErr(&errOut)
+	t.Cleanup(func() { testRoot.SetOut(nil); testRoot.SetErr(nil) })
+	testRoot.SetArgs(streamCommand)
+
+	if err := testRoot.ExecuteContext(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("error = %v, want the context error", err)
+	}
+	if !strings.HasPrefix(out.String(), "first\n") {
+		t.Errorf("expected the first message, got:\n%s", out.String())
+	}
+	if want := render.RenderStreamSummary(1, 6, context.Canceled); !strings.Contains(errOut.String(), want) {
+		t.Errorf("summary not written to the command stderr:\n%s", errOut.String())
+	}
+}
+
+// droppedBody is a response body failing after its content
+type droppedBody struct {
+	io.Reader
+}
+
+func (b droppedBody) Read(p []byte) (int, error) {
+	n, err := b.Reader.Read(p)
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return n, err
+}
+
+func (droppedBody) Close() error { return nil }
+
+// logsClient returns deployment logs dropped on the first call
+type logsClient struct {
+	*fakeClient
+}
+
+func (c logsClient) DeploymentLogs(ctx context.Context, deployURL string) (*http.Response, error) {
+	c.record("DeploymentLogs", []any{deployURL})
+	body := io.ReadCloser(io.NopCloser(strings.NewReader("line 2\n")))
+	if len(c.callsTo("DeploymentLogs")) == 1 {
+		body = droppedBody{strings.NewReader("line 1\n")}
+	}
+	return &http.Response{StatusCode: http.StatusOK, Body: body}, nil
+}
+
+func TestStreamResponseReconnect(t *testing.T) {
+	fake := useFakeClient(t)
+	newClient = func(ctx context.Context) (Client, error) {
+		return logsClient{fake}, nil
+	}
+	prevDelay := reconnectDelay
+	reconnectDelay = 0
+	t.Cleanup(func() { reconnectDelay = prevDelay })
+	resetFlags(t, []string{"deployments", "deployment-logs"}, "deploy-url", "reconnect")
+
+	out, errOut, err := runStream(t, "deployments", "deployment-logs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertOutput(t, out, "line 1\nline 2\n")
+	if !strings.Contains(errOut, "unexpected EOF") {
+		t.Errorf("reconnection not reported:\n%s", errOut)
+	}
+}
@@ -0,0 +1,148 @@
+package generator
+
+import "strings"
+
+// RowAction is a command run on the selected row of an interactive table,
+// with the field of the row identifying the item as the value of one of its
+// flags
+type RowAction struct {
+	Key     string   // "enter" for the command showing the item, a letter for the others
+	Command string   // Name of the command, a sibling of the list (e.g., "alert-remove")
+	Flag    string   // Flag set to the field of the row (e.g., "id")
+	Field   string   // Field of the row (e.g., "ID")
+	Copied  []string // Flags of the list command passed on as is (e.g., "addon-id")
+}
+
+// rowActionKeys are the keys of the table browser that can't trigger an action
+var rowActionKeys = map[string]bool{"q": true, "j": true, "k": true, "g": true}
+
+// sharedParamItems maps shared parameters to the type they identify and the
+// field of that type holding their value
+var sharedParamItems = map[string]struct{ Type, Field string }{
+	"app":     {"App", "Name"},
+	"appName": {"App", "Name"},
+}
+
+// detectRowActions finds the sibling commands an interactive list can run on
+// its rows: those taking a single param identifying the listed type, either an
+// ID param resolved from a list of that type or a shared param such as the
+// app, every other param being one the list command also takes. The command
+// showing the listed type runs on enter, the others get the first free letter
+// of their name.
+func detectRowActions(list CommandDef, siblings []CommandDef) []RowAction {
+	if !list.ListFlags || !strings.HasPrefix(list.ReturnType, "[]") {
+		return nil
+	}
+	listed := strings.TrimLeft(list.ReturnType, "[]*")
+
+	var actions []RowAction
+	taken := make(map[string]bool)
+	for k := range rowActionKeys {
+		taken[k] = true
+	}
+	for _, sibling := range siblings {
+		if sibling.Custom || sibling.Key == list.Key {
+			continue
+		}
+		action, ok := rowAction(list, sibling, listed)
+		if !ok {
+			continue
+		}
+
+		returned := strings.TrimPrefix(GetPrimaryReturnType(sibling.Method), "*")
+		if returned == listed && showsItem(sibling.Use, listed) && !taken["enter"] {
+			action.Key = "enter"
+		} else if action.Key = actionKey(sibling.Use, listed, taken); action.Key == "" {
+			continue
+		}
+		taken[action.Key] = true
+		actions = append(actions, action)
+	}
+	return actions
+}
+
+// rowAction maps the params of sibling to the row and the flags of list,
+// reporting whether it identifies the listed item by a single param
+func rowAction(list, sibling CommandDef, listed string) (RowAction, bool) {
+	action := RowAction{Command: sibling.Use}
+	for _, param := range sibling.Method.Params {
+		item, shared := sharedParamItems[param.Name]
+		switch {
+		case param.ResolvedFrom != nil && param.ResolvedFrom.Type == listed:
+			if action.Flag != "" {
+				return RowAction{}, false
+			}
+			action.Flag, action.Field = names.Flag(param.Name), "ID"
+		case shared && item.Type == listed && !hasParam(list.Method, param.Name):
+			if action.Flag != "" {
+				return RowAction{}, false
+			}
+			action.Flag, action.Field = sharedFlagNames[param.Name], item.Field
+		case shared && hasParam(list.Method, param.Name):
+			// Given by the root flag to both commands
+		case !shared && hasParam(list.Method, param.Name) && param.Type == "string":
+			action.Copied = append(action.Copied, names.Flag(param.Name))
+		default:
+			return RowAction{}, false
+		}
+	}
+	return action, action.Flag != ""
+}
+
+// hasParam reports whether method takes a param with this name
+func hasParam(method Method, name string) bool {
+	for _, param := range method.Params {
+		if param.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// showsItem reports whether a command name only shows the listed type, like
+// alert-show or deployment, rather than changing it like domain-set-canonical
+func showsItem(use, listed string) bool {
+	words := actionWords(use, listed)
+	return len(words) == 0 || len(words) == 1 && (words[0] == "show" || words[0] == "get")
+}
+
+// actionWords returns the words of a command name not naming the listed type
+// (e.g., "remove" for alert-remove listing alerts)
+func actionWords(use, listed string) []string {
+	typeWords := make(map[string]bool)
+	for _, w := range names.Split(listed) {
+		typeWords[strings.ToLower(w)] = true
+		typeWords[strings.ToLower(w)+"s"] = true
+	}
+	var words []string
+	for _, w := range strings.Split(use, "-") {
+		if !typeWords[w] {
+			words = append(words, w)
+		}
+	}
+	return words
+}
+
+// actionKey returns the first free letter of a command name, leaving out the
+// words naming the listed type (e.g., "r" for alert-remove listing alerts), ""
+// when every letter is taken
+func actionKey(use, listed string, taken map[string]bool) string {
+	words := actionWords(use, listed)
+	if len(words) == 0 {
+		words = strings.Split(use, "-")
+	}
+
+	for _, w := range words {
+		if key := w[:1]; !taken[key] {
+			return key
+		}
+	}
+	for _, w := range words {
+		for _, r := range w {
+			if key := string(r); r >= 'a' && r <= 'z' && !taken[key] {
+				return key
+			}
+		}
+	}
+	return ""
+}
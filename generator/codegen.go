@@ -1,65 +1,146 @@
 package generator
 
 import (
-	"bytes"
 	"fmt"
-	"go/format"
-	"os"
-	"path/filepath"
+	"maps"
+	"slices"
+	"sort"
 	"strings"
-	"text/template"
 )
 
 const commandTemplate = `// Code generated by generative-cli. DO NOT EDIT.
 package commands
 
 import (
-	"context"
 	{{if or .NeedsJSON .NeedsIO}}"encoding/json"
 	{{end}}{{if .NeedsIO}}"io"
 	{{end}}"fmt"
 
-	scalingo "github.com/Scalingo/go-scalingo/v8"
-	"github.com/spf13/cobra"
+	{{if .NeedsSDK}}scalingo "github.com/Scalingo/go-scalingo/v8"
+	{{end}}"github.com/spf13/cobra"
 
-	"generative-cli/config"
-	"generative-cli/render"
+	{{if .NeedsConfig}}"generative-cli/config"
+	{{end}}"generative-cli/render"
 )
 
-{{range $cmd := .Commands}}
+{{range $cmd := .Commands}}{{if $cmd.Custom}}
+// {{$cmd.VarName}} is a stub: {{$cmd.Method.Name}} is marked custom in the manifest, so
+// the command is hand-written and registered with ReplaceCommand("{{$cmd.Key}}", ...)
+var {{$cmd.VarName}} = &cobra.Command{
+	Use:   "{{$cmd.Use}}",{{if $cmd.Aliases}}
+	Aliases: []string{ {{- range $i, $a := $cmd.Aliases}}{{if $i}}, {{end}}"{{$a}}"{{end -}} },{{end}}
+	Short: "{{$cmd.Short}}",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		err := fmt.Errorf("{{$cmd.Key}} is a custom command and no implementation is registered")
+		fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+		return err
+	},
+}
+{{else}}
 var {{$cmd.VarName}} = &cobra.Command{
-	Use:   "{{$cmd.Use}}",
+	Use:   "{{$cmd.Use}}",{{if $cmd.Aliases}}
+	Aliases: []string{ {{- range $i, $a := $cmd.Aliases}}{{if $i}}, {{end}}"{{$a}}"{{end -}} },{{end}}
 	Short: "{{$cmd.Short}}",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		ctx := context.Background()
+		{{template "run" $cmd}}
+	},
+}
 
-		authToken, err := config.C.LoadAuth()
-		if err != nil {
-			fmt.Println(render.RenderError(err))
+func init{{$cmd.VarName}}() {
+	{{template "flags" $cmd}}
+}
+{{end}}{{end}}
+
+// Register{{.ServiceName}}Commands registers all generated commands with the parent
+func Register{{.ServiceName}}Commands(parent *cobra.Command) {
+	serviceCmd := &cobra.Command{
+		Use:   "{{.ServiceLower}}",{{if .ServiceAliases}}
+		Aliases: []string{ {{- range $i, $a := .ServiceAliases}}{{if $i}}, {{end}}"{{$a}}"{{end -}} },{{end}}
+		Short: "{{.ServiceName}} operations",
+	}
+	{{range $cmd := .Commands}}{{if $cmd.Custom}}
+	serviceCmd.AddCommand(replacedCommand("{{$cmd.Key}}", {{$cmd.VarName}})){{else}}
+	init{{$cmd.VarName}}()
+	serviceCmd.AddCommand({{$cmd.VarName}}){{end}}
+	{{end}}
+	parent.AddCommand(serviceCmd)
+}
+`
+
+// runTemplate renders the body of a command's RunE function. Its data is the
+// CommandDef.
+const runTemplate = `{{$cmd := .}}// Cancelled on SIGINT/SIGTERM or when --timeout expires
+		ctx := cmd.Context()
+
+		// Hand-written hooks, see RegisterHooks
+		hooks := registeredHooks["{{$cmd.Key}}"]
+		if err := hooks.preRun(cmd, args); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		client, err := scalingo.New(ctx, scalingo.ClientConfig{
-			APIToken: authToken,
-			Region:   config.C.GetRegion(),
-		})
+		{{if and (ne $cmd.RendererType "success") (ne $cmd.RendererType "http")}}outputFormat, err := selectedFormat()
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 
-		{{if ne $cmd.RendererType "success"}}outputFormat, _ := cmd.Flags().GetString("output")
-		{{end}}{{range $cmd.FlagVars}}
-		{{if .NeedsJSON}}{{.Name}}JSON, _ := cmd.Flags().Get{{.GetterType}}("{{.FlagName}}")
+		{{end}}client, err := newClient(ctx)
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+		{{range $cmd.FlagVars}}
+		{{if .ConfigGetter}}{{.Name}}, err := config.C.{{.ConfigGetter}}()
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}{{else if .NeedsJSON}}{{.Name}}JSON, _ := cmd.Flags().Get{{.GetterType}}("{{.FlagName}}")
 		var {{.Name}} {{.JSONType}}
 		if {{.Name}}JSON != "" {
 			if err := json.Unmarshal([]byte({{.Name}}JSON), &{{.Name}}); err != nil {
-				fmt.Println(render.RenderError(fmt.Errorf("invalid JSON for {{.FlagName}}: %w", err)))
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(fmt.Errorf("invalid JSON for {{.FlagName}}: %w", err)))
 				return err
 			}
 		}{{else if .TypeCast}}{{.Name}}Raw, _ := cmd.Flags().Get{{.GetterType}}("{{.FlagName}}")
 		{{.Name}} := {{.TypeCast}}({{.Name}}Raw){{else}}{{.Name}}, _ := cmd.Flags().Get{{.GetterType}}("{{.FlagName}}"){{end}}
 		{{end}}
+		if err := hooks.beforeCall(cmd, client); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+		{{range $r := $cmd.Resolvers}}
+		// Resolve --{{$r.Flag}} or --{{$r.SelectorFlag}} from {{$r.MethodName}}, IDs being used as is
+		{{$r.Var}}Selector, _ := cmd.Flags().GetString("{{$r.SelectorFlag}}")
+		if looksLikeID({{$r.Var}}Selector) {
+			{{$r.Var}} = {{$r.Var}}Selector
+		} else if {{$r.Var}}Selector != "" || {{$r.Var}} != "" && !looksLikeID({{$r.Var}}) {
+			{{$r.Var}}List, err := client.{{$r.MethodName}}({{$r.CallArgs}})
+			if err == nil {
+				{{$r.Var}}Items := make([]resolveItem, len({{$r.Var}}List))
+				for i, item := range {{$r.Var}}List {
+					{{$r.Var}}Items[i].ID = item.ID{{if $r.NameFields}}
+					{{$r.Var}}Items[i].Names = []string{ {{- range $i, $f := $r.NameFields}}{{if $i}}, {{end}}item.{{$f}}{{end -}} }{{end}}{{if eq $r.CreatedAt "time.Time"}}
+					{{$r.Var}}Items[i].CreatedAt = item.CreatedAt{{else if eq $r.CreatedAt "*time.Time"}}
+					if item.CreatedAt != nil {
+						{{$r.Var}}Items[i].CreatedAt = *item.CreatedAt
+					}{{end}}
+				}
+				if {{$r.Var}}Selector != "" {
+					{{$r.Var}}, err = resolveID("{{$r.Kind}}", {{$r.Var}}Selector, {{$r.Var}}Items, {{$r.Exact}})
+				} else {
+					{{$r.Var}}, err = resolveName("{{$r.Kind}}", {{$r.Var}}, {{$r.Var}}Items, {{$r.Exact}})
+				}
+			} else if {{$r.Var}}Selector == "" {
+				// --{{$r.Flag}} goes to the API as given when it can't be listed
+				err = nil
+			}
+			if err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+		}
+		{{end}}
 		{{range $builder := $cmd.StructBuilders}}
 		{{$builder.VarName}} := {{if $builder.IsPointer}}&{{end}}scalingo.{{$builder.TypeName}}{
 			{{range $f := $builder.Fields}}{{if not $f.Skip}}{{$f.FieldName}}: {{if $f.NeedsDeref}}&{{end}}{{$f.FlagVar}},
@@ -70,13 +151,13 @@ var {{$cmd.VarName}} = &cobra.Command{
 		// Fetch {{$chain.ResultVar}} by calling {{$chain.MethodName}}
 		{{if $chain.ExtractURL}}{{$chain.ResultVar}}Resp, err := client.{{$chain.MethodName}}({{$chain.CallArgs}})
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 		defer {{$chain.ResultVar}}Resp.Body.Close()
 		{{$chain.ResultVar}}Bytes, err := io.ReadAll({{$chain.ResultVar}}Resp.Body)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 		// Parse JSON response to extract the URL
@@ -84,105 +165,156 @@ var {{$cmd.VarName}} = &cobra.Command{
 			LogsURL string ` + "`json:\"logs_url\"`" + `
 		}
 		if err := json.Unmarshal({{$chain.ResultVar}}Bytes, &{{$chain.ResultVar}}Data); err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 		{{$chain.ResultVar}} := {{$chain.ResultVar}}Data.LogsURL
 		{{else}}{{$chain.ResultVar}}, err := client.{{$chain.MethodName}}({{$chain.CallArgs}})
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 		{{end}}{{end}}
 		{{if $cmd.AutoPaginate}}
-		var allResults {{$cmd.ReturnTypeWithPkg}}
-		page := 1
-		for {
-			results, meta, err := client.{{$cmd.MethodName}}({{$cmd.SDKCallArgs}}, scalingo.PaginationOpts{Page: page, PerPage: 100})
-			if err != nil {
-				fmt.Println(render.RenderError(err))
-				return err
-			}
-			allResults = append(allResults, results...)
-			if meta.NextPage == 0 {
-				break
-			}
-			page = meta.NextPage
-		}
-		result := allResults
-		{{else if eq $cmd.RendererType "success"}}
-		if err := client.{{$cmd.MethodName}}({{$cmd.SDKCallArgs}}); err != nil {
-			fmt.Println(render.RenderError(err))
+		err = paginate(cmd, hooks, outputFormat, func(opts scalingo.PaginationOpts) ({{$cmd.ReturnTypeWithPkg}}, scalingo.PaginationMeta, error) {
+			return client.{{$cmd.MethodName}}({{$cmd.SDKCallArgs}}, opts)
+		}{{range $cmd.PageEnvelope}}, "{{.}}"{{end}})
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(render.RenderSuccess("{{$cmd.Use}} completed successfully"))
 		{{else if $cmd.HasExtraReturn}}
-		result, _, err := client.{{$cmd.MethodName}}({{$cmd.SDKCallArgs}})
+		{{range $cmd.Results}}{{.Var}}, {{end}}err {{if $cmd.RenderedResults}}:{{end}}= client.{{$cmd.MethodName}}({{$cmd.SDKCallArgs}})
+		if err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+		{{if gt (len $cmd.RenderedResults) 1}}result := render.Results{
+			{{range $cmd.RenderedResults}}{Name: "{{.Name}}", Value: {{.Var}}},
+			{{end}}
+		}
+		{{else if not $cmd.RenderedResults}}fmt.Fprintln(cmd.OutOrStdout(), render.RenderSuccess("{{$cmd.Use}} completed successfully"))
+		{{end}}
+		{{else if eq $cmd.RendererType "stream"}}
+		err = stream(cmd, outputFormat, func() (any, error) {
+			return client.{{$cmd.MethodName}}({{$cmd.SDKCallArgs}})
+		})
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+		{{else if eq $cmd.RendererType "success"}}
+		if err := client.{{$cmd.MethodName}}({{$cmd.SDKCallArgs}}); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
+		fmt.Fprintln(cmd.OutOrStdout(), render.RenderSuccess("{{$cmd.Use}} completed successfully"))
 		{{else}}
 		result, err := client.{{$cmd.MethodName}}({{$cmd.SDKCallArgs}})
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
 		{{end}}
-		{{if ne $cmd.RendererType "success"}}
-		output, err := render.RenderResult(result, render.OutputFormat(outputFormat))
+		{{if eq $cmd.RendererType "http"}}
+		if err := render.StreamResponse(ctx, cmd.OutOrStdout(), cmd.ErrOrStderr(), result); err != nil {
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+			return err
+		}
+		{{else if and (ne $cmd.RendererType "success") (ne $cmd.RendererType "stream") (not $cmd.AutoPaginate)}}{{if $cmd.ListFlags}}
+		if browsing(cmd) {
+			if err := browse(cmd, hooks, result); err != nil {
+				fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
+				return err
+			}
+			return nil
+		}
+		{{end}}
+		output, err := hooks.render(cmd, result, outputFormat)
 		if err != nil {
-			fmt.Println(render.RenderError(err))
+			fmt.Fprintln(cmd.OutOrStdout(), render.RenderError(err))
 			return err
 		}
-		fmt.Println(output)
+		fmt.Fprintln(cmd.OutOrStdout(), output)
 		{{end}}
-		return nil
-	},
-}
+		return nil`
 
-func init{{$cmd.VarName}}() {
-	{{range $cmd.Flags}}{{if .Shorthand}}
+// flagsTemplate renders the body of a command's init function, declaring its
+// flags. Its data is the CommandDef.
+const flagsTemplate = `{{$cmd := .}}{{range $cmd.Flags}}{{if .Shorthand}}
 	{{$cmd.VarName}}.Flags().{{.Type}}P("{{.Name}}", "{{.Shorthand}}", {{.Default}}, "{{.Usage}}"){{else}}
 	{{$cmd.VarName}}.Flags().{{.Type}}("{{.Name}}", {{.Default}}, "{{.Usage}}"){{end}}
-	{{end}}
-	{{$cmd.VarName}}.Flags().StringP("output", "o", "table", "Output format (table, json)")
-}
-{{end}}
-
-// Register{{.ServiceName}}Commands registers all generated commands with the parent
-func Register{{.ServiceName}}Commands(parent *cobra.Command) {
-	serviceCmd := &cobra.Command{
-		Use:   "{{.ServiceLower}}",
-		Short: "{{.ServiceName}} operations",
-	}
-	{{range $cmd := .Commands}}
-	init{{$cmd.VarName}}()
-	serviceCmd.AddCommand({{$cmd.VarName}})
-	{{end}}
-	parent.AddCommand(serviceCmd)
-}
-`
+	{{end}}{{if $cmd.AutoPaginate}}
+	addPaginationFlags({{$cmd.VarName}})
+	{{end}}{{if $cmd.ListFlags}}
+	addListFlags({{$cmd.VarName}})
+	{{end}}{{if eq $cmd.RendererType "stream"}}
+	addStreamFlags({{$cmd.VarName}})
+	{{end}}{{range $cmd.Resolvers}}
+	{{$cmd.VarName}}.MarkFlagsMutuallyExclusive("{{.Flag}}", "{{.SelectorFlag}}")
+	{{end}}`
 
 // CommandDef represents a command to be generated
 type CommandDef struct {
+	Service           string // SDK service the command belongs to (e.g., "AppsService")
+	Method            Method // SDK method the command calls
+	Key               string // Command path below the root (e.g., "apps create"), used to register hooks
+	Custom            bool   // Hand-written command: only a stub is generated
 	VarName           string
 	Use               string
+	Aliases           []string // Names the command had before, see legacyCommand
 	Short             string
 	MethodName        string
 	MethodCall        string
 	HasParams         bool
 	FlagVars          []FlagVar
 	Flags             []FlagDef
-	RendererType      string          // "table", "detail", "success"
+	RendererType      string          // "table", "detail", "success", "http", "stream"
 	ReturnType        string          // Primary return type (e.g., "[]*App")
 	ReturnTypeWithPkg string          // Return type with scalingo package prefix (e.g., "[]*scalingo.App")
-	AutoPaginate      bool            // Whether to auto-fetch all pages
+	AutoPaginate      bool            // Whether to fetch pages with the pagination flags
+	ListFlags         bool            // Whether to sort and filter the items of the result with the list flags
 	StructBuilders    []StructBuilder // Structs to build from flags
 	SDKCallArgs       string          // Arguments for SDK call (e.g., "ctx, app, opts")
 	HasExtraReturn    bool            // True if method returns (result, statusCode, error) pattern
+	// Results are the non-error returns of methods returning several values,
+	// RenderedResults the ones rendered
+	Results         []ResultDef
+	RenderedResults []ResultDef
+	// PageEnvelope names the items and the meta of paginated methods rendering
+	// their PaginationMeta, wrapped together in JSON output
+	PageEnvelope []string
 	// ChainedCalls holds pre-calls needed to fetch chained parameters
 	ChainedCalls []ChainedCall
+	// Resolvers resolve ID flags from list methods
+	Resolvers []Resolver
+	// RowActions are the sibling commands --interactive runs on the rows
+	RowActions []RowAction
+	// Test describes the generated test exercising this command
+	Test CommandTest
+}
+
+// ResultDef is a return value of the SDK call
+type ResultDef struct {
+	Name string // Key in the JSON envelope (e.g., "status")
+	Var  string // Variable holding the value, "_" when it isn't rendered
+}
+
+// ColumnPreset is a manifest override of the default table columns of an SDK type
+type ColumnPreset struct {
+	Type    string   // SDK type name (e.g., "App")
+	Columns []string // Dotted column paths (e.g., "owner.username")
+}
+
+// columnPresets are the table column overrides registered by the generated code
+var columnPresets []ColumnPreset
+
+// ConfigureColumns sets the table column overrides of the manifest, by SDK type name
+func ConfigureColumns(columns map[string][]string) {
+	columnPresets = nil
+	for _, typeName := range slices.Sorted(maps.Keys(columns)) {
+		columnPresets = append(columnPresets, ColumnPreset{Type: typeName, Columns: columns[typeName]})
+	}
 }
 
 // ChainedCall represents a method call that must be made before the main call
@@ -195,14 +327,29 @@ type ChainedCall struct {
 	SourceFlagVar string // Flag variable used in the call (e.g., "app")
 }
 
+// Resolver resolves an ID flag from a list method, with a flag selecting the
+// item (e.g., --deployment latest instead of --deploy <id>)
+type Resolver struct {
+	Var          string   // Variable holding the ID (e.g., "deploy")
+	Flag         string   // Flag providing the ID (e.g., "deploy")
+	SelectorFlag string   // Flag selecting the item (e.g., "deployment")
+	Kind         string   // Identified type in messages (e.g., "deployment")
+	MethodName   string   // List method (e.g., "DeploymentList")
+	CallArgs     string   // Arguments of the list method (e.g., "ctx, app")
+	CreatedAt    string   // Type of the CreatedAt field of the listed type, empty if none
+	NameFields   []string // String fields of the listed type matched as names
+	Exact        bool     // Whether prefixes are left out, for destructive commands
+}
+
 // FlagVar represents a variable to read from flags
 type FlagVar struct {
-	Name       string
-	FlagName   string
-	GetterType string
-	TypeCast   string // Type to cast to (e.g., "scalingo.SCMType"), empty if no cast needed
-	NeedsJSON  bool   // True if param needs JSON unmarshaling (complex types like Variables)
-	JSONType   string // Type for JSON unmarshal (e.g., "scalingo.Variables")
+	Name         string
+	FlagName     string
+	GetterType   string
+	TypeCast     string // Type to cast to (e.g., "scalingo.SCMType"), empty if no cast needed
+	NeedsJSON    bool   // True if param needs JSON unmarshaling (complex types like Variables)
+	JSONType     string // Type for JSON unmarshal (e.g., "scalingo.Variables")
+	ConfigGetter string // Config method providing the value from the root flags (e.g., "RequireApp")
 }
 
 // FlagDef represents a flag definition
@@ -212,64 +359,846 @@ type FlagDef struct {
 	Default   string
 	Usage     string
 	Shorthand string // Single letter shorthand (e.g., "n" for -n)
+	Source    string // SDK parameter or field the flag is derived from
+	Resolves  string // ID flag resolved by this selector flag, empty for other flags
 }
 
 // ServiceFile represents a generated service file
 type ServiceFile struct {
-	ServiceName  string
-	ServiceLower string
-	Commands     []CommandDef
-	NeedsJSON    bool // True if any command needs JSON unmarshaling
-	NeedsIO      bool // True if any command needs io.ReadAll for chained responses
+	ServiceName    string
+	ServiceLower   string
+	ServiceAliases []string // Names the service command had before, see legacyCommand
+	Commands       []CommandDef
+	NeedsJSON      bool // True if any command needs JSON unmarshaling
+	NeedsIO        bool // True if any command needs io.ReadAll for chained responses
+	NeedsSDK       bool // True if any command references the scalingo package
+	NeedsConfig    bool // True if any command reads a flag default through config
 }
 
+const clientTemplate = `// Code generated by generative-cli. DO NOT EDIT.
+package commands
+
+import (
+	"context"
+
+	scalingo "github.com/Scalingo/go-scalingo/v8"
+
+	"generative-cli/config"
+)
+
+// Client is the set of SDK services used by the generated commands
+type Client interface {
+{{range .}}	scalingo.{{.ServiceName}}
+{{end}}}
+
+// newClient creates the SDK client used by the generated commands. Generated
+// tests replace it with a fake client.
+var newClient = func(ctx context.Context) (Client, error) {
+	authToken, err := config.C.LoadAuth()
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := scalingo.New(ctx, scalingo.ClientConfig{
+		APIToken: authToken,
+		Region:   config.C.GetRegion(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+`
+
 const registerTemplate = `// Code generated by generative-cli. DO NOT EDIT.
 package commands
 
 import "github.com/spf13/cobra"
 
-// RegisterAll registers all generated service commands with the parent command
+// RegisterAll registers all generated service commands with the parent command,
+// along with the hand-written commands attached with AttachCommand
 func RegisterAll(parent *cobra.Command) {
-{{range .}}	Register{{.}}Commands(parent)
-{{end}}}
+{{range .}}	Register{{.ServiceName}}Commands(parent)
+{{end}}
+	attachCommands(parent)
+}
 `
 
-// GenerateCommands generates Go code for the given methods
-func GenerateCommands(newMethods map[string][]Method, structs map[string]ParsedStruct, outputPath string) error {
-	if err := os.MkdirAll(outputPath, 0755); err != nil {
-		return fmt.Errorf("failed to create output directory: %w", err)
+const registryTemplate = `// Code generated by generative-cli. DO NOT EDIT.
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// customKeys are the keys of the commands marked custom in the manifest. Only a
+// stub is generated for them, replaced with ReplaceCommand.
+var customKeys = map[string]bool{
+{{range .}}{{range .Commands}}{{if .Custom}}	"{{.Key}}": true,
+{{end}}{{end}}{{end}}}
+
+// attachedCommand is a hand-written command added below a parent command
+type attachedCommand struct {
+	parentKey string
+	cmd       *cobra.Command
+}
+
+var (
+	// replacedCommands maps the keys of custom commands to their implementation
+	replacedCommands = make(map[string]*cobra.Command)
+	// attachedCommands are added to the command tree by RegisterAll
+	attachedCommands []attachedCommand
+)
+
+// ReplaceCommand provides the hand-written implementation of the command with
+// the given key (e.g., "deployments create"), whose method must be marked
+// custom = true in the manifest. Call it from an init function of a
+// hand-written file of this package. It panics if the method isn't marked
+// custom or if it's already replaced, so stale registrations fail as soon as
+// the CLI starts.
+func ReplaceCommand(key string, cmd *cobra.Command) {
+	if !customKeys[key] {
+		panic(fmt.Sprintf("ReplaceCommand: %q is not marked custom in the manifest", key))
+	}
+	if _, ok := replacedCommands[key]; ok {
+		panic(fmt.Sprintf("ReplaceCommand: %q is already replaced", key))
+	}
+	replacedCommands[key] = cmd
+}
+
+// AttachCommand adds a hand-written command below the command with the given
+// key: a service (e.g., "deployments"), a generated command, or "" for the
+// root. Call it from an init function of a hand-written file of this package.
+func AttachCommand(parentKey string, cmd *cobra.Command) {
+	attachedCommands = append(attachedCommands, attachedCommand{parentKey, cmd})
+}
+
+// replacedCommand returns the implementation registered for a custom command,
+// or its stub
+func replacedCommand(key string, stub *cobra.Command) *cobra.Command {
+	if cmd, ok := replacedCommands[key]; ok {
+		return cmd
+	}
+	return stub
+}
+
+// attachCommands adds the commands registered with AttachCommand below root. It
+// panics if a parent doesn't exist or already has a command with the same name.
+func attachCommands(root *cobra.Command) {
+	for _, attached := range attachedCommands {
+		parent := root
+		for _, name := range strings.Fields(attached.parentKey) {
+			parent = childCommand(parent, name)
+			if parent == nil {
+				panic(fmt.Sprintf("AttachCommand: no command %q", attached.parentKey))
+			}
+		}
+		if childCommand(parent, attached.cmd.Name()) != nil {
+			panic(fmt.Sprintf("AttachCommand: %q already has a %s command (use ReplaceCommand to replace a generated one)", attached.parentKey, attached.cmd.Name()))
+		}
+		parent.AddCommand(attached.cmd)
+	}
+}
+
+// childCommand returns the subcommand of parent with the given name, nil if there is none
+func childCommand(parent *cobra.Command, name string) *cobra.Command {
+	for _, cmd := range parent.Commands() {
+		if cmd.Name() == name {
+			return cmd
+		}
+	}
+	return nil
+}
+`
+
+const paginateTemplate = `// Code generated by generative-cli. DO NOT EDIT.
+package commands
+
+import (
+	"fmt"
+
+	scalingo "github.com/Scalingo/go-scalingo/v8"
+	"github.com/spf13/cobra"
+
+	"generative-cli/render"
+)
+
+// defaultPerPage is the page size used when --per-page isn't set
+const defaultPerPage = 100
+
+// addPaginationFlags declares the flags selecting the pages a paginated command fetches
+func addPaginationFlags(cmd *cobra.Command) {
+	cmd.Flags().Int("page", 1, "Page to fetch (the first one with --all or --limit)")
+	cmd.Flags().Int("per-page", defaultPerPage, "Number of items per page")
+	cmd.Flags().Int("limit", 0, "Fetch pages until this many items are shown, after --filter (0 for no limit)")
+	cmd.Flags().Bool("all", false, "Fetch every page")
+}
+
+// paginate fetches the pages selected by the pagination flags and renders them.
+// The formats of render.StreamsPages are written page by page as they are
+// fetched, unless hooks change the result or how it is rendered. envelope
+// names the items and the meta when the meta is rendered too: they are then
+// rendered together as render.Results, only tables being streamed. Pages are
+// never streamed with --query or --sort-by, which apply to the whole result,
+// and the footer counts the items left by --filter. With --interactive, the
+// items fetched are browsed instead.
+func paginate[S ~[]E, E any](cmd *cobra.Command, hooks *Hooks, format render.OutputFormat, fetch func(opts scalingo.PaginationOpts) (S, scalingo.PaginationMeta, error), envelope ...string) error {
+	page, _ := cmd.Flags().GetInt("page")
+	perPage, _ := cmd.Flags().GetInt("per-page")
+	limit, _ := cmd.Flags().GetInt("limit")
+	all, _ := cmd.Flags().GetBool("all")
+	switch {
+	case page < 1:
+		return fmt.Errorf("--page must be at least 1, got %d", page)
+	case perPage < 1:
+		return fmt.Errorf("--per-page must be at least 1, got %d", perPage)
+	case limit < 0:
+		return fmt.Errorf("--limit must be positive, got %d", limit)
+	}
+	if limit > 0 && limit < perPage && !cmd.Flags().Changed("per-page") {
+		perPage = limit
+	}
+
+	var pages *render.PageWriter
+	opts := renderOptions(cmd)
+	streams := render.StreamsPages(format) && opts.Query == "" && len(opts.SortBy) == 0 && (len(envelope) == 0 || format == render.FormatTable) && !browsing(cmd)
+	if streams && (hooks == nil || hooks.AfterCall == nil && hooks.Render == nil) {
+		pages = render.NewPageWriter(cmd.OutOrStdout(), format, opts)
+	}
+
+	var results S
+	var meta scalingo.PaginationMeta
+	shown := 0
+	for {
+		items, pageMeta, err := fetch(scalingo.PaginationOpts{Page: page, PerPage: perPage})
+		if err != nil {
+			return err
+		}
+		meta = pageMeta
+		// --limit counts the items left by --filter, sorting waiting for every page
+		filtered, err := render.Arrange(items, render.Options{Filters: opts.Filters})
+		if err != nil {
+			return err
+		}
+		items = filtered.(S)
+		if limit > 0 && shown+len(items) > limit {
+			items = items[:limit-shown]
+		}
+		shown += len(items)
+
+		if pages != nil {
+			if err := pages.Write(items); err != nil {
+				return err
+			}
+		} else {
+			results = append(results, items...)
+		}
+
+		if (!all && limit == 0) || (limit > 0 && shown >= limit) || meta.NextPage == 0 {
+			break
+		}
+		page = meta.NextPage
 	}
 
-	tmpl, err := template.New("command").Parse(commandTemplate)
+	if pages != nil {
+		return pages.Close(meta, page)
+	}
+	arranged, err := render.Arrange(results, opts)
 	if err != nil {
-		return fmt.Errorf("failed to parse template: %w", err)
+		return err
 	}
+	results = arranged.(S)
+	shown = len(results)
+	if browsing(cmd) {
+		return browse(cmd, hooks, results)
+	}
+	var result any = results
+	if len(envelope) == 2 {
+		result = render.Results{
+			{Name: envelope[0], Value: results},
+			{Name: envelope[1], Value: meta},
+		}
+	}
+	output, err := hooks.render(cmd, result, format)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(cmd.OutOrStdout(), output)
+	if footer := render.RenderPageFooter(format, meta, page, shown); footer != "" && opts.Query == "" {
+		fmt.Fprintln(cmd.OutOrStdout(), footer)
+	}
+	return nil
+}
+`
 
-	var serviceNames []string
+const streamTemplate = `// Code generated by generative-cli. DO NOT EDIT.
+package commands
 
-	for serviceName, methods := range newMethods {
-		serviceNames = append(serviceNames, serviceName)
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"generative-cli/render"
+)
+
+// reconnectDelay is the wait before connecting again to a dropped stream,
+// doubled on each attempt up to maxReconnectDelay
+var reconnectDelay = time.Second
+
+const maxReconnectDelay = 30 * time.Second
+
+// addStreamFlags declares the flags of commands rendering a stream
+func addStreamFlags(cmd *cobra.Command) {
+	cmd.Flags().Int("reconnect", 5, "Number of times to connect again when the stream drops (0 to fail instead)")
+}
+
+// stream connects with connect and renders the stream as it arrives. When it
+// drops before the server ended it, connect is called again up to --reconnect
+// times. The error tells how the stream ended: nil when the server ended it,
+// a *render.StreamStatusError on a failure status.
+func stream(cmd *cobra.Command, format render.OutputFormat, connect func() (any, error)) error {
+	ctx := cmd.Context()
+	reconnects, _ := cmd.Flags().GetInt("reconnect")
+	delay := reconnectDelay
+	for attempt := 1; ; attempt++ {
+		conn, err := connect()
+		if err != nil && attempt == 1 {
+			return err
+		}
+		if err == nil {
+			err = render.StreamResult(ctx, cmd.OutOrStdout(), cmd.ErrOrStderr(), conn, format)
+			if !errors.Is(err, render.ErrStreamDropped) {
+				return err
+			}
+		}
+		if attempt > reconnects {
+			return err
+		}
+
+		fmt.Fprintln(cmd.ErrOrStderr(), render.RenderWarning(fmt.Sprintf("%v, connecting again in %s (%d/%d)", err, delay, attempt, reconnects)))
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, maxReconnectDelay)
+	}
+}
+`
+
+const outputTemplate = `// Code generated by generative-cli. DO NOT EDIT.
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"generative-cli/config"
+	"generative-cli/render"
+)
+{{if .}}
+// init registers the table columns of the manifest, replacing the default ones
+func init() {
+{{- range .}}
+	render.RegisterColumns({{printf "%q" .Type}}{{range .Columns}}, {{printf "%q" .}}{{end}})
+{{- end}}
+}
+{{end}}
+// selectedFormat returns the output format selected by --output, or the
+// go-template read from --template-file. Templates and --query are checked
+// before any SDK call.
+func selectedFormat() (render.OutputFormat, error) {
+	name := config.C.GetOutput()
+	if config.C.TemplateFileFlag != "" {
+		template, err := os.ReadFile(config.C.TemplateFileFlag)
+		if err != nil {
+			return "", fmt.Errorf("failed to read --template-file: %w", err)
+		}
+		name = string(render.FormatGoTemplate) + "=" + string(template)
+	}
+	format, err := render.ParseFormat(name)
+	if err != nil {
+		return "", err
+	}
+	if err := render.CheckQuery(config.C.QueryFlag); err != nil {
+		return "", err
+	}
+	return format, nil
+}
+
+// addListFlags declares the flags sorting, filtering and browsing the items of
+// a list. --interactive is -i, flags of the command declaring -i losing it.
+func addListFlags(cmd *cobra.Command) {
+	cmd.Flags().Var(&render.SortKeys{}, "sort-by", "Sort items by these fields, descending when prefixed with - (e.g., status,-created_at)")
+	cmd.Flags().Var(&render.Filters{}, "filter", "Only show items matching every condition, with = != ~ (contains) !~ < <= > >= (e.g., status=running,region~osc)")
+	cmd.Flags().BoolP("interactive", "i", false, "Browse the items in a table: / filters them, enter shows the selected one")
+}
+
+// renderOptions returns the rendering options selected by the root flags and
+// the list flags of cmd
+func renderOptions(cmd *cobra.Command) render.Options {
+	opts := render.Options{
+		Columns: config.C.ColumnsFlag,
+		Wide:    config.C.WideFlag,
+		Depth:   config.C.DepthFlag,
+		Query:   config.C.QueryFlag,
+	}
+	if flag := cmd.Flags().Lookup("sort-by"); flag != nil {
+		opts.SortBy = *flag.Value.(*render.SortKeys)
+	}
+	if flag := cmd.Flags().Lookup("filter"); flag != nil {
+		opts.Filters = *flag.Value.(*render.Filters)
+	}
+	return opts
+}
+`
+
+const browseTemplate = `// Code generated by generative-cli. DO NOT EDIT.
+package commands
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"generative-cli/render"
+)
+
+// rowAction is a sibling command the table browser runs on the selected row,
+// one of its flags being set to a field of the row
+type rowAction struct {
+	key     string   // "enter" for the command showing the row, a letter for the others
+	command string   // Name of the command
+	flag    string   // Flag set to the field (e.g., "id")
+	field   string   // Field of the row (e.g., "ID")
+	copied  []string // Flags of the list command passed on as is
+}
+
+// rowActions are the actions of the table browser by list command key
+var rowActions = map[string][]rowAction{
+{{- range .}}{{range .Commands}}{{if .RowActions}}
+	"{{.Key}}": {
+	{{- range .RowActions}}
+		{key: "{{.Key}}", command: "{{.Command}}", flag: "{{.Flag}}", field: "{{.Field}}"{{if .Copied}}, copied: []string{ {{- range $i, $f := .Copied}}{{if $i}}, {{end}}"{{$f}}"{{end -}} }{{end}}},
+	{{- end}}
+	},
+{{- end}}{{end}}{{end}}
+}
+
+// browsing reports whether cmd was run with --interactive
+func browsing(cmd *cobra.Command) bool {
+	interactive, _ := cmd.Flags().GetBool("interactive")
+	return interactive
+}
+
+// browse opens the table browser on the items of result until it is quit
+func browse(cmd *cobra.Command, hooks *Hooks, result any) error {
+	browser, err := newBrowser(cmd, hooks, result)
+	if err != nil {
+		return err
+	}
+	program := tea.NewProgram(browser, tea.WithAltScreen(), tea.WithContext(cmd.Context()),
+		tea.WithInput(cmd.InOrStdin()), tea.WithOutput(render.Unpaged(cmd.OutOrStdout())))
+	_, err = program.Run()
+	return err
+}
+
+// newBrowser creates the table browser of the items of result, after the
+// AfterCall hook, with the row actions of cmd
+func newBrowser(cmd *cobra.Command, hooks *Hooks, result any) (*render.Browser, error) {
+	result, err := hooks.afterCall(cmd, result)
+	if err != nil {
+		return nil, err
+	}
+
+	var actions []render.BrowserAction
+	for _, action := range rowActions[cmd.Parent().Name()+" "+cmd.Name()] {
+		sibling := childCommand(cmd.Parent(), action.command)
+		if sibling == nil {
+			continue
+		}
+		actions = append(actions, render.BrowserAction{
+			Key:     action.key,
+			Name:    action.command,
+			Confirm: action.key != "enter",
+			Describe: func(item any) string {
+				return fmt.Sprintf("%s --%s %s", sibling.CommandPath(), action.flag, rowField(item, action.field))
+			},
+			Run: func(item any) (string, error) {
+				return runRowAction(cmd, sibling, action, item)
+			},
+		})
+	}
+	return render.NewBrowser(result, renderOptions(cmd), actions)
+}
+
+// runRowAction runs the command of an action on item, returning its output.
+// The flags it sets are restored afterwards.
+func runRowAction(cmd, sibling *cobra.Command, action rowAction, item any) (string, error) {
+	values := map[string]string{action.flag: rowField(item, action.field)}
+	for _, name := range action.copied {
+		values[name] = cmd.Flags().Lookup(name).Value.String()
+	}
+	for name, value := range values {
+		flag := sibling.Flags().Lookup(name)
+		if flag == nil {
+			flag = sibling.InheritedFlags().Lookup(name)
+		}
+		if flag == nil {
+			return "", fmt.Errorf("%s has no --%s flag", sibling.CommandPath(), name)
+		}
+		defer func(flag *pflag.Flag, value string, changed bool) {
+			flag.Value.Set(value)
+			flag.Changed = changed
+		}(flag, flag.Value.String(), flag.Changed)
+		if err := flag.Value.Set(value); err != nil {
+			return "", err
+		}
+		flag.Changed = true
+	}
+
+	var out bytes.Buffer
+	sibling.SetOut(&out)
+	defer sibling.SetOut(nil)
+	sibling.SetContext(cmd.Context())
+	err := sibling.RunE(sibling, nil)
+	return out.String(), err
+}
+
+// rowField returns a field of a row item as a flag value
+func rowField(item any, field string) string {
+	v := reflect.Indirect(reflect.ValueOf(item))
+	if v.Kind() != reflect.Struct {
+		return ""
+	}
+	f := reflect.Indirect(v.FieldByName(field))
+	if !f.IsValid() {
+		return ""
+	}
+	return fmt.Sprint(f.Interface())
+}
+`
+
+const resolveTemplate = `// Code generated by generative-cli. DO NOT EDIT.
+package commands
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// resolveItem is an item returned by a list method, used to resolve an ID flag
+type resolveItem struct {
+	ID        string
+	Names     []string  // Names the item can be selected by, e.g. its name or email
+	CreatedAt time.Time // Zero when the listed type has no creation date
+}
+
+// String describes the item in the candidates of an ambiguous match
+func (item resolveItem) String() string {
+	var names []string
+	for _, name := range item.Names {
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return item.ID
+	}
+	return fmt.Sprintf("%s (%s)", item.ID, strings.Join(names, ", "))
+}
+
+// idPattern matches the IDs of the API: UUIDs, possibly prefixed by a short
+// kind (e.g., "ad-" for addons), and 24 hex digit object IDs
+var idPattern = regexp.MustCompile("^([a-z]{1,5}-)?[0-9a-f]{8}(-[0-9a-f]{4}){3}-[0-9a-f]{12}$|^[0-9a-f]{24}$")
+
+// looksLikeID reports whether value is an ID of the API, used as is without
+// calling the list method. Items beyond the first page of a list method can
+// only be selected this way.
+func looksLikeID(value string) bool {
+	return idPattern.MatchString(value)
+}
+
+// resolveID returns the ID of the item picked by selector among the items
+// returned by a list method: "latest" picks the most recently created item (the
+// first one when items have no creation date), anything else is matched like
+// resolveName and must match an item.
+func resolveID(kind, selector string, items []resolveItem, exact bool) (string, error) {
+	if len(items) == 0 {
+		return "", fmt.Errorf("no %s found", kind)
+	}
+
+	if selector == "latest" {
+		latest := items[0]
+		for _, item := range items[1:] {
+			if item.CreatedAt.After(latest.CreatedAt) {
+				latest = item
+			}
+		}
+		return latest.ID, nil
+	}
+
+	id, found, err := matchItem(kind, selector, items, exact)
+	switch {
+	case err != nil:
+		return "", err
+	case !found && exact:
+		return "", fmt.Errorf("no %s %q found (use latest, an ID or a name)", kind, selector)
+	case !found:
+		return "", fmt.Errorf("no %s %q found (use latest, an ID, a name or a unique prefix)", kind, selector)
+	}
+	return id, nil
+}
+
+// resolveName returns the ID of the item value refers to: its ID, one of its
+// names, or unless exact, a prefix of them matching a single item. A value
+// matching no item is returned as is, so IDs missing from the list still reach
+// the API.
+func resolveName(kind, value string, items []resolveItem, exact bool) (string, error) {
+	id, found, err := matchItem(kind, value, items, exact)
+	if err != nil || !found {
+		return value, err
+	}
+	return id, nil
+}
+
+// matchItem finds the item value refers to, preferring exact matches of an ID,
+// then of a name, then unless exact, a unique prefix. It fails listing the
+// candidates when several items match.
+func matchItem(kind, value string, items []resolveItem, exact bool) (string, bool, error) {
+	for _, item := range items {
+		if item.ID == value {
+			return item.ID, true, nil
+		}
+	}
+
+	matches := []func(s string) bool{
+		func(s string) bool { return s == value },
+	}
+	if !exact {
+		matches = append(matches, func(s string) bool { return strings.HasPrefix(s, value) })
+	}
+	for i, match := range matches {
+		var candidates []resolveItem
+		for _, item := range items {
+			if (i > 0 && match(item.ID)) || slices.ContainsFunc(item.Names, match) {
+				candidates = append(candidates, item)
+			}
+		}
+		switch {
+		case len(candidates) == 1:
+			return candidates[0].ID, true, nil
+		case len(candidates) > 1:
+			var list []string
+			for _, item := range candidates {
+				list = append(list, "  "+item.String())
+			}
+			return "", false, fmt.Errorf("%q matches %d %ss, use one of:\n%s", value, len(candidates), kind, strings.Join(list, "\n"))
+		}
+	}
+	return "", false, nil
+}
+`
+
+const hooksTemplate = `// Code generated by generative-cli. DO NOT EDIT.
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"generative-cli/render"
+)
+
+// Hooks customize a generated command without editing generated code. They are
+// registered by command key from a hand-written file of this package, which
+// regeneration leaves alone:
+//
+//	func init() {
+//		RegisterHooks("apps create", Hooks{
+//			PreRun: func(cmd *cobra.Command, args []string) error {
+//				if !cmd.Flags().Changed("stack-id") {
+//					return cmd.Flags().Set("stack-id", "scalingo-22")
+//				}
+//				return nil
+//			},
+//		})
+//	}
+//
+// Every hook is optional. AfterCall and Render only apply to commands
+// rendering a result, the table browser of --interactive only applying
+// AfterCall.
+type Hooks struct {
+	// PreRun runs before the flags are read, so it can set or validate them
+	PreRun func(cmd *cobra.Command, args []string) error
+	// BeforeCall runs once the flags are read, before names given to ID flags
+	// are resolved and the SDK is called
+	BeforeCall func(cmd *cobra.Command, client Client) error
+	// AfterCall transforms the result before it is rendered
+	AfterCall func(cmd *cobra.Command, result any) (any, error)
+	// Render replaces the renderer selected by --output
+	Render func(cmd *cobra.Command, result any, format render.OutputFormat) (string, error)
+}
+
+// commandKeys are the keys of the generated commands, custom ones excepted
+var commandKeys = map[string]bool{
+{{range .}}{{range .Commands}}{{if not .Custom}}	"{{.Key}}": true,
+{{end}}{{end}}{{end}}}
+
+// registeredHooks maps command keys to their hooks
+var registeredHooks = make(map[string]*Hooks)
+
+// RegisterHooks registers the hooks of the generated command with the given key
+// (e.g., "apps create"). It panics if there is no such command or if it already
+// has hooks, so stale registrations fail as soon as the CLI starts.
+func RegisterHooks(key string, hooks Hooks) {
+	if !commandKeys[key] {
+		panic(fmt.Sprintf("RegisterHooks: no generated command %q", key))
+	}
+	if _, ok := registeredHooks[key]; ok {
+		panic(fmt.Sprintf("RegisterHooks: hooks already registered for %q", key))
+	}
+	registeredHooks[key] = &hooks
+}
+
+func (h *Hooks) preRun(cmd *cobra.Command, args []string) error {
+	if h == nil || h.PreRun == nil {
+		return nil
+	}
+	return h.PreRun(cmd, args)
+}
+
+func (h *Hooks) beforeCall(cmd *cobra.Command, client Client) error {
+	if h == nil || h.BeforeCall == nil {
+		return nil
+	}
+	return h.BeforeCall(cmd, client)
+}
+
+func (h *Hooks) afterCall(cmd *cobra.Command, result any) (any, error) {
+	if h == nil || h.AfterCall == nil {
+		return result, nil
+	}
+	return h.AfterCall(cmd, result)
+}
+
+// render renders the result of a command, through its AfterCall and Render hooks
+func (h *Hooks) render(cmd *cobra.Command, result any, format render.OutputFormat) (string, error) {
+	result, err := h.afterCall(cmd, result)
+	if err != nil {
+		return "", err
+	}
+	if h != nil && h.Render != nil {
+		return h.Render(cmd, result, format)
+	}
+	return render.Render(result, format, renderOptions(cmd))
+}
+`
+
+// GenerateCommands generates Go code for the given methods with the built-in
+// templates and writes the files that changed to outputPath
+func GenerateCommands(newMethods map[string][]Method, structs map[string]ParsedStruct, outputPath string) error {
+	files, err := RenderCommands(newMethods, structs, DefaultTemplates())
+	if err != nil {
+		return err
+	}
+	_, err = WriteFiles(outputPath, files)
+	return err
+}
 
+// RenderCommands generates Go code for the given methods with templates, keyed
+// by file name. The output only depends on its inputs: services are rendered in
+// name order.
+func RenderCommands(newMethods map[string][]Method, structs map[string]ParsedStruct, templates *Templates) (GeneratedFiles, error) {
+	serviceNames := sortedServiceNames(newMethods)
+	var serviceFiles []ServiceFile
+	var collisions []string
+
+	// Generated names share the commands package, so they must be unique across services
+	varNames := newNameCollisions("commands package", "variable")
+	fileNames := newNameCollisions("commands package", "file")
+	fileNames.add("register", "RegisterAll")
+	fileNames.add("client", "Client")
+	fileNames.add("hooks", "Hooks")
+	fileNames.add("registry", "ReplaceCommand")
+	fileNames.add("resolve", "resolveID")
+	fileNames.add("paginate", "paginate")
+	fileNames.add("stream", "stream")
+	fileNames.add("output", "selectedFormat")
+	fileNames.add("browse", "browse")
+
+	for _, serviceName := range serviceNames {
+		prefix := strings.TrimSuffix(serviceName, "Service")
 		sf := ServiceFile{
 			ServiceName:  serviceName,
-			ServiceLower: toSnakeCase(strings.TrimSuffix(serviceName, "Service")),
+			ServiceLower: names.Command(prefix),
+		}
+		if legacy := legacyCommand(prefix, "_"); legacy != sf.ServiceLower {
+			sf.ServiceAliases = []string{legacy}
 		}
+		fileNames.add(names.File(prefix), serviceName)
 
-		for _, method := range methods {
-			// Skip hidden methods (used only for chaining)
-			if method.Hidden {
-				continue
-			}
-			cmd := methodToCommand(serviceName, method, structs)
+		uses := newNameCollisions(serviceName, "command")
+		for _, method := range newMethods[serviceName] {
+			cmd := methodToCommand(serviceName, method, structs, newMethods)
+			cmd.Test = buildCommandTest(sf.ServiceLower, cmd, method, newMethods)
 			sf.Commands = append(sf.Commands, cmd)
+
+			collisions = append(collisions, checkCommandNames(serviceName, method, cmd)...)
+			uses.add(cmd.Use, method.Name)
+			for _, alias := range cmd.Aliases {
+				uses.add(alias, method.Name)
+			}
+			varNames.add(cmd.VarName, serviceName+"."+method.Name)
 		}
+		for j := range sf.Commands {
+			sf.Commands[j].RowActions = detectRowActions(sf.Commands[j], sf.Commands)
+		}
+		collisions = append(collisions, uses.errors...)
+		serviceFiles = append(serviceFiles, sf)
+	}
 
-		// Check if any command needs JSON unmarshaling or io package
+	collisions = append(collisions, varNames.errors...)
+	collisions = append(collisions, fileNames.errors...)
+	if err := collisionError(collisions); err != nil {
+		return nil, err
+	}
+
+	files := make(GeneratedFiles)
+	for i := range serviceFiles {
+		sf := &serviceFiles[i]
+
+		// Check which packages the commands need. Custom command stubs only need
+		// the packages every file imports.
 		for _, cmd := range sf.Commands {
+			if cmd.Custom {
+				continue
+			}
+			if usesSDKPackage(cmd) {
+				sf.NeedsSDK = true
+			}
 			for _, fv := range cmd.FlagVars {
 				if fv.NeedsJSON {
 					sf.NeedsJSON = true
-					break
+				}
+				if fv.ConfigGetter != "" {
+					sf.NeedsConfig = true
 				}
 			}
 			// Check if any chained call needs io.ReadAll
@@ -281,70 +1210,119 @@ func GenerateCommands(newMethods map[string][]Method, structs map[string]ParsedS
 			}
 		}
 
-		var buf bytes.Buffer
-		if err := tmpl.Execute(&buf, sf); err != nil {
-			return fmt.Errorf("failed to execute template for %s: %w", serviceName, err)
+		filename := names.File(strings.TrimSuffix(sf.ServiceName, "Service")) + ".go"
+		var err error
+		if files[filename], err = templates.execute("command", sf); err != nil {
+			return nil, fmt.Errorf("failed to generate %s: %w", filename, err)
 		}
+	}
 
-		// Format the code
-		formatted, err := format.Source(buf.Bytes())
-		if err != nil {
-			// If formatting fails, write unformatted for debugging
-			formatted = buf.Bytes()
-		}
+	// Generate register.go
+	var err error
+	if files["register.go"], err = templates.execute("register", serviceFiles); err != nil {
+		return nil, fmt.Errorf("failed to generate register.go: %w", err)
+	}
 
-		filename := filepath.Join(outputPath, toSnakeCase(strings.TrimSuffix(serviceName, "Service"))+".go")
-		if err := os.WriteFile(filename, formatted, 0644); err != nil {
-			return fmt.Errorf("failed to write %s: %w", filename, err)
-		}
+	// Generate client.go
+	if files["client.go"], err = templates.execute("client", serviceFiles); err != nil {
+		return nil, fmt.Errorf("failed to generate client.go: %w", err)
 	}
 
-	// Generate register.go
-	regTmpl, err := template.New("register").Parse(registerTemplate)
-	if err != nil {
-		return fmt.Errorf("failed to parse register template: %w", err)
+	// Generate registry.go
+	if files["registry.go"], err = templates.execute("registry", serviceFiles); err != nil {
+		return nil, fmt.Errorf("failed to generate registry.go: %w", err)
 	}
 
-	var buf bytes.Buffer
-	if err := regTmpl.Execute(&buf, serviceNames); err != nil {
-		return fmt.Errorf("failed to execute register template: %w", err)
+	// Generate paginate.go
+	if files["paginate.go"], err = templates.execute("paginate", nil); err != nil {
+		return nil, fmt.Errorf("failed to generate paginate.go: %w", err)
 	}
 
-	formatted, err := format.Source(buf.Bytes())
-	if err != nil {
-		formatted = buf.Bytes()
+	// Generate stream.go
+	if files["stream.go"], err = templates.execute("stream", nil); err != nil {
+		return nil, fmt.Errorf("failed to generate stream.go: %w", err)
 	}
 
-	if err := os.WriteFile(filepath.Join(outputPath, "register.go"), formatted, 0644); err != nil {
-		return fmt.Errorf("failed to write register.go: %w", err)
+	// Generate output.go
+	if files["output.go"], err = templates.execute("output", columnPresets); err != nil {
+		return nil, fmt.Errorf("failed to generate output.go: %w", err)
 	}
 
-	return nil
+	// Generate browse.go
+	if files["browse.go"], err = templates.execute("browse", serviceFiles); err != nil {
+		return nil, fmt.Errorf("failed to generate browse.go: %w", err)
+	}
+
+	// Generate resolve.go
+	if files["resolve.go"], err = templates.execute("resolve", nil); err != nil {
+		return nil, fmt.Errorf("failed to generate resolve.go: %w", err)
+	}
+
+	// Generate hooks.go
+	if files["hooks.go"], err = templates.execute("hooks", serviceFiles); err != nil {
+		return nil, fmt.Errorf("failed to generate hooks.go: %w", err)
+	}
+
+	if err := renderTests(files, templates, serviceFiles, newMethods, structs); err != nil {
+		return nil, err
+	}
+
+	// Files that don't format are kept as is so they can be inspected
+	if errs := formatFiles(files, declOrigins(newMethods)); len(errs) > 0 {
+		return files, errs
+	}
+	return files, nil
 }
 
-func methodToCommand(serviceName string, method Method, structs map[string]ParsedStruct) CommandDef {
-	// Convert method name to command use
-	// e.g., AppsList -> list, AppsCreate -> create
+// commandVarName returns the name of the variable holding a method's command.
+// It includes the service name to avoid conflicts between services.
+func commandVarName(serviceName, methodName string) string {
 	prefix := strings.TrimSuffix(serviceName, "Service")
-	use := strings.TrimPrefix(method.Name, prefix)
-	use = toKebabCase(use)
+	return names.Var(prefix) + names.Pascal(commandUse(serviceName, methodName)) + "Cmd"
+}
 
-	// Handle case where method name equals service prefix (e.g., Logs -> Logs)
-	if use == "" {
-		use = "run"
+// sortedServiceNames returns the service names of newMethods in sorted order
+func sortedServiceNames(newMethods map[string][]Method) []string {
+	serviceNames := make([]string, 0, len(newMethods))
+	for serviceName := range newMethods {
+		serviceNames = append(serviceNames, serviceName)
 	}
+	sort.Strings(serviceNames)
+	return serviceNames
+}
+
+// usesSDKPackage reports whether the generated command references the scalingo package
+func usesSDKPackage(cmd CommandDef) bool {
+	if cmd.AutoPaginate || len(cmd.StructBuilders) > 0 {
+		return true
+	}
+	for _, fv := range cmd.FlagVars {
+		if fv.NeedsJSON || fv.TypeCast != "" {
+			return true
+		}
+	}
+	return false
+}
 
-	// Include service name in var to avoid conflicts between services
-	varPrefix := toCamelCase(strings.TrimSuffix(serviceName, "Service"))
+func methodToCommand(serviceName string, method Method, structs map[string]ParsedStruct, newMethods map[string][]Method) CommandDef {
+	prefix := strings.TrimSuffix(serviceName, "Service")
+	use := commandUse(serviceName, method.Name)
 
 	// Get return type and renderer type
 	returnType := GetPrimaryReturnType(method)
 	rendererType := InferRendererType(returnType)
+	if IsStreamingMethod(method) {
+		rendererType = "stream"
+	}
 	returnTypeWithPkg := addScalingoPrefix(returnType)
 
 	cmd := CommandDef{
-		VarName:           varPrefix + toPascalCase(use) + "Cmd",
+		Service:           serviceName,
+		Method:            method,
+		Key:               names.Command(prefix) + " " + use,
+		VarName:           commandVarName(serviceName, method.Name),
 		Use:               use,
+		Aliases:           commandAliases(serviceName, method.Name, use),
 		Short:             fmt.Sprintf("%s %s", prefix, use),
 		MethodName:        method.Name,
 		HasParams:         len(method.Params) > 0,
@@ -353,6 +1331,12 @@ func methodToCommand(serviceName string, method Method, structs map[string]Parse
 		RendererType:      rendererType,
 	}
 
+	// Hand-written commands only get a stub
+	if method.Custom {
+		cmd.Custom = true
+		return cmd
+	}
+
 	// Check for extra return value (like status code in VariableSet returning *Variable, int, error)
 	nonErrorReturns := 0
 	for _, ret := range method.Returns {
@@ -362,6 +1346,7 @@ func methodToCommand(serviceName string, method Method, structs map[string]Parse
 	}
 	if nonErrorReturns > 1 {
 		cmd.HasExtraReturn = true
+		cmd.Results, cmd.RenderedResults = resultDefs(method)
 	}
 
 	// Build method call arguments
@@ -384,28 +1369,32 @@ func methodToCommand(serviceName string, method Method, structs map[string]Parse
 			var sourceCallArgs []string
 			sourceCallArgs = append(sourceCallArgs, "ctx")
 			for _, srcParam := range param.ChainedFrom.SourceParams {
-				flag := paramToFlag(srcParam)
-				cmd.Flags = append(cmd.Flags, flag)
 				fv := FlagVar{
-					Name:       toCamelCase(srcParam.Name),
-					FlagName:   toKebabCase(srcParam.Name),
+					Name:       names.Var(srcParam.Name),
+					FlagName:   paramFlagName(srcParam),
 					GetterType: flagGetterType(srcParam.Type),
 				}
+				if getter, ok := sharedParams[srcParam.Name]; ok {
+					fv.ConfigGetter = getter
+				} else {
+					cmd.Flags = append(cmd.Flags, paramToFlag(srcParam))
+				}
 				cmd.FlagVars = append(cmd.FlagVars, fv)
-				sourceCallArgs = append(sourceCallArgs, toCamelCase(srcParam.Name))
+				sourceCallArgs = append(sourceCallArgs, names.Var(srcParam.Name))
 			}
 
 			// Create the chained call
 			chainedCall := ChainedCall{
 				MethodName: param.ChainedFrom.MethodName,
-				ResultVar:  toCamelCase(param.Name),
+				ResultVar:  names.Var(param.Name),
 				CallArgs:   strings.Join(sourceCallArgs, ", "),
-				ExtractURL: true, // Assume *http.Response for now, could be smarter
+				// The URL is read from the JSON body of an *http.Response
+				ExtractURL: param.ChainedFrom.ReturnType == "*http.Response",
 			}
 			cmd.ChainedCalls = append(cmd.ChainedCalls, chainedCall)
 
 			// Use the chained result variable in the main call
-			callArgs = append(callArgs, toCamelCase(param.Name))
+			callArgs = append(callArgs, names.Var(param.Name))
 			continue
 		}
 
@@ -420,6 +1409,16 @@ func methodToCommand(serviceName string, method Method, structs map[string]Parse
 				if field.Skip {
 					continue
 				}
+				// Fields such as RunOpts.App come from the persistent root flags
+				if getter, ok := sharedParams[names.Var(field.FieldName)]; ok && field.FieldType == "string" {
+					cmd.FlagVars = append(cmd.FlagVars, FlagVar{
+						Name:         field.FlagVar,
+						FlagName:     sharedFlagNames[names.Var(field.FieldName)],
+						GetterType:   flagGetterType(field.FieldType),
+						ConfigGetter: getter,
+					})
+					continue
+				}
 				cmd.Flags = append(cmd.Flags, structFieldToFlag(field))
 				cmd.FlagVars = append(cmd.FlagVars, FlagVar{
 					Name:       field.FlagVar,
@@ -427,17 +1426,41 @@ func methodToCommand(serviceName string, method Method, structs map[string]Parse
 					GetterType: flagGetterType(field.FieldType),
 				})
 			}
+		} else if getter, ok := sharedParams[param.Name]; ok {
+			// Shared parameter - read from the persistent root flags
+			cmd.FlagVars = append(cmd.FlagVars, FlagVar{
+				Name:         names.Var(param.Name),
+				FlagName:     paramFlagName(param),
+				GetterType:   flagGetterType(param.Type),
+				ConfigGetter: getter,
+			})
+			callArgs = append(callArgs, names.Var(param.Name))
 		} else {
 			// Simple parameter - create flag directly
 			flag := paramToFlag(param)
 			cmd.Flags = append(cmd.Flags, flag)
 
 			fv := FlagVar{
-				Name:       toCamelCase(param.Name),
-				FlagName:   toKebabCase(param.Name),
+				Name:       names.Var(param.Name),
+				FlagName:   names.Flag(param.Name),
 				GetterType: flagGetterType(param.Type),
 			}
 
+			// ID flags can also be resolved from a list method
+			if r := param.ResolvedFrom; r != nil && hasMethod(newMethods, r.Service, r.MethodName) {
+				resolver := paramResolver(param, method)
+				cmd.Resolvers = append(cmd.Resolvers, resolver)
+				cmd.Flags[len(cmd.Flags)-1].Usage = resolvedUsage(r, resolver.Exact)
+				cmd.Flags = append(cmd.Flags, FlagDef{
+					Name:     resolver.SelectorFlag,
+					Type:     "String",
+					Default:  `""`,
+					Usage:    fmt.Sprintf("%s to use instead of --%s: latest, or its %s", r.Type, flag.Name, strings.TrimPrefix(resolvedUsage(r, resolver.Exact), r.Type+" ")),
+					Source:   r.MethodName,
+					Resolves: flag.Name,
+				})
+			}
+
 			// Check if we need JSON unmarshaling for complex types
 			if needsJSONUnmarshal(param.Type) {
 				fv.NeedsJSON = true
@@ -448,16 +1471,107 @@ func methodToCommand(serviceName string, method Method, structs map[string]Parse
 			}
 
 			cmd.FlagVars = append(cmd.FlagVars, fv)
-			callArgs = append(callArgs, toCamelCase(param.Name))
+			callArgs = append(callArgs, names.Var(param.Name))
 		}
 	}
 
 	cmd.SDKCallArgs = strings.Join(callArgs, ", ")
 	cmd.MethodCall = fmt.Sprintf("%s(%s)", method.Name, cmd.SDKCallArgs)
 
+	switch {
+	case cmd.AutoPaginate && len(cmd.RenderedResults) == 2:
+		// The pagination meta is rendered too
+		cmd.PageEnvelope = []string{cmd.RenderedResults[0].Name, cmd.RenderedResults[1].Name}
+	case !cmd.AutoPaginate && cmd.HasExtraReturn && len(cmd.RenderedResults) == 0:
+		cmd.RendererType = "success"
+	}
+	// Named slice types (e.g. Events) are only known to be slices once paginated
+	cmd.ListFlags = cmd.RendererType == "table" || cmd.AutoPaginate
+	if cmd.ListFlags {
+		// The shorthands of the list flags win over the inferred ones
+		for i, f := range cmd.Flags {
+			if listShorthands[f.Shorthand] {
+				cmd.Flags[i].Shorthand = ""
+			}
+		}
+	}
+
 	return cmd
 }
 
+// resultDefs names the variables holding the results of a method returning
+// several values: "result" when a single one is rendered, "result<Name>" when
+// several are, to be wrapped in render.Results
+func resultDefs(method Method) (results, rendered []ResultDef) {
+	methodResults := method.Results
+	if methodResults == nil {
+		methodResults = DefaultResults(method)
+	}
+	count := 0
+	for _, r := range methodResults {
+		if r.Render {
+			count++
+		}
+	}
+	for _, r := range methodResults {
+		def := ResultDef{Name: r.Name, Var: "_"}
+		if r.Render {
+			def.Var = "result"
+			if count > 1 {
+				def.Var += names.Pascal(r.Name)
+			}
+			rendered = append(rendered, def)
+		}
+		results = append(results, def)
+	}
+	return results, rendered
+}
+
+// paramResolver builds the resolver of an ID param from its list method
+func paramResolver(param Param, method Method) Resolver {
+	r := param.ResolvedFrom
+	callArgs := []string{"ctx"}
+	for _, arg := range r.Args {
+		callArgs = append(callArgs, names.Var(arg))
+	}
+	return Resolver{
+		Var:          names.Var(param.Name),
+		Flag:         names.Flag(param.Name),
+		SelectorFlag: names.Flag(r.Type),
+		Kind:         strings.ToLower(strings.Join(names.Split(r.Type), " ")),
+		MethodName:   r.MethodName,
+		CallArgs:     strings.Join(callArgs, ", "),
+		CreatedAt:    r.CreatedAt,
+		NameFields:   r.NameFields,
+		Exact:        isDestructive(method),
+	}
+}
+
+// resolvedUsage describes the values a resolved ID flag accepts, prefixes
+// being left out when exact
+func resolvedUsage(r *ResolvedParam, exact bool) string {
+	fields := strings.ToLower(strings.Join(r.NameFields, ", "))
+	switch {
+	case exact && len(r.NameFields) == 0:
+		return r.Type + " ID"
+	case exact:
+		return fmt.Sprintf("%s ID or %s", r.Type, fields)
+	case len(r.NameFields) == 0:
+		return r.Type + " ID or unique ID prefix"
+	}
+	return fmt.Sprintf("%s ID, %s or unique prefix", r.Type, fields)
+}
+
+// hasMethod reports whether the method is part of the generated methods
+func hasMethod(newMethods map[string][]Method, serviceName, methodName string) bool {
+	for _, method := range newMethods[serviceName] {
+		if method.Name == methodName {
+			return true
+		}
+	}
+	return false
+}
+
 // expandStructParam creates a StructBuilder from a struct parameter
 func expandStructParam(param Param, structs map[string]ParsedStruct) StructBuilder {
 	baseType := strings.TrimPrefix(param.Type, "*")
@@ -466,7 +1580,7 @@ func expandStructParam(param Param, structs map[string]ParsedStruct) StructBuild
 	// Use a distinct variable name to avoid collisions with flag variables
 	// param.Name is often "opts" or "params", so we keep it as-is since it's used in the SDK call
 	builder := StructBuilder{
-		VarName:   toCamelCase(param.Name),
+		VarName:   names.Var(param.Name),
 		TypeName:  baseType,
 		IsPointer: strings.HasPrefix(param.Type, "*"),
 	}
@@ -478,13 +1592,13 @@ func expandStructParam(param Param, structs map[string]ParsedStruct) StructBuild
 		}
 
 		// Add "Flag" suffix to avoid collision with struct variable
-		flagVar := toCamelCase(field.Name) + "Flag"
+		flagVar := names.Var(field.Name + "Flag")
 
 		fm := StructFieldMapping{
 			FieldName: field.Name,
 			FlagVar:   flagVar,
 			FieldType: field.Type,
-			FlagName:  toKebabCasePreserveAcronyms(field.Name),
+			FlagName:  names.Flag(field.Name),
 			IsPointer: strings.HasPrefix(field.Type, "*"),
 		}
 
@@ -516,8 +1630,9 @@ func expandStructParam(param Param, structs map[string]ParsedStruct) StructBuild
 // structFieldToFlag converts a struct field mapping to a flag definition
 func structFieldToFlag(field StructFieldMapping) FlagDef {
 	flag := FlagDef{
-		Name:  field.FlagName,
-		Usage: fmt.Sprintf("%s field", field.FieldName),
+		Name:   field.FlagName,
+		Usage:  fmt.Sprintf("%s field", field.FieldName),
+		Source: field.FieldName,
 	}
 
 	// Strip pointer prefix for type detection
@@ -552,10 +1667,33 @@ func structFieldToFlag(field StructFieldMapping) FlagDef {
 	return flag
 }
 
+// sharedParams maps parameter names to the config getter returning their value.
+// These parameters are provided by the persistent root flags (e.g., --app) so
+// generated commands don't declare their own flag for them.
+var sharedParams = map[string]string{
+	"app":     "RequireApp",
+	"appName": "RequireApp",
+}
+
+// sharedFlagNames maps shared parameter names to the root flag providing them
+var sharedFlagNames = map[string]string{
+	"app":     "app",
+	"appName": "app",
+}
+
+// paramFlagName returns the CLI flag name used for a method parameter
+func paramFlagName(param Param) string {
+	if name, ok := sharedFlagNames[param.Name]; ok {
+		return name
+	}
+	return names.Flag(param.Name)
+}
+
 func paramToFlag(param Param) FlagDef {
 	flag := FlagDef{
-		Name:  toKebabCase(param.Name),
-		Usage: fmt.Sprintf("%s parameter", param.Name),
+		Name:   names.Flag(param.Name),
+		Usage:  fmt.Sprintf("%s parameter", param.Name),
+		Source: param.Name,
 	}
 
 	// Add common shorthands
@@ -574,6 +1712,12 @@ func paramToFlag(param Param) FlagDef {
 	case "bool":
 		flag.Type = "Bool"
 		flag.Default = "false"
+	case "float64":
+		flag.Type = "Float64"
+		flag.Default = "0"
+	case "[]string":
+		flag.Type = "StringSlice"
+		flag.Default = "nil"
 	default:
 		// For complex types, use string and let user provide JSON
 		flag.Type = "String"
@@ -678,6 +1822,14 @@ func needsTypeCast(paramType string) bool {
 
 // addScalingoPrefix adds the scalingo. package prefix to custom types
 // e.g., "[]*App" -> "[]*scalingo.App", "map[string]string" -> "map[string]string"
+// builtinTypes are the predeclared types, never prefixed with the SDK package
+var builtinTypes = map[string]bool{
+	"string": true, "bool": true, "byte": true, "rune": true, "error": true,
+	"int": true, "int8": true, "int16": true, "int32": true, "int64": true,
+	"uint": true, "uint8": true, "uint16": true, "uint32": true, "uint64": true,
+	"float32": true, "float64": true, "interface{}": true, "any": true,
+}
+
 func addScalingoPrefix(returnType string) string {
 	if returnType == "" {
 		return returnType
@@ -696,12 +1848,7 @@ func addScalingoPrefix(returnType string) string {
 	}
 
 	// Don't prefix built-in types
-	builtins := map[string]bool{
-		"string": true, "int": true, "int64": true, "int32": true,
-		"float64": true, "float32": true, "bool": true, "byte": true,
-		"error": true, "interface{}": true,
-	}
-	if builtins[returnType] {
+	if builtinTypes[returnType] {
 		return returnType
 	}
 
@@ -719,70 +1866,105 @@ func addScalingoPrefix(returnType string) string {
 	return "scalingo." + returnType
 }
 
-func toSnakeCase(s string) string {
-	var result strings.Builder
-	for i, r := range s {
-		if i > 0 && r >= 'A' && r <= 'Z' {
-			result.WriteRune('_')
-		}
-		result.WriteRune(r)
+// commandUse returns the command name for a method, e.g. AppsList -> list
+func commandUse(serviceName, methodName string) string {
+	prefix := strings.TrimSuffix(serviceName, "Service")
+	use := names.Command(strings.TrimPrefix(methodName, prefix))
+
+	// Handle case where method name equals service prefix (e.g., Logs -> Logs)
+	if use == "" {
+		use = "run"
 	}
-	return strings.ToLower(result.String())
+	return use
 }
 
-func toKebabCase(s string) string {
-	return strings.ReplaceAll(toSnakeCase(s), "_", "-")
+// commandAliases returns the legacy name of a command when it differs from use
+func commandAliases(serviceName, methodName, use string) []string {
+	legacy := legacyCommand(strings.TrimPrefix(methodName, strings.TrimSuffix(serviceName, "Service")), "-")
+	if legacy == "" {
+		legacy = "run"
+	}
+	if legacy == use {
+		return nil
+	}
+	return []string{legacy}
 }
 
-// toKebabCasePreserveAcronyms converts to kebab-case while preserving common acronyms
-// e.g., "StackID" -> "stack-id", "HTTPS" -> "https", "ProjectID" -> "project-id"
-func toKebabCasePreserveAcronyms(s string) string {
-	// Common acronyms to preserve
-	acronyms := []string{"ID", "URL", "HTTP", "HTTPS", "API", "UUID", "SSH", "TLS", "DNS", "TCP", "UDP", "IP"}
+// templateVars are the variables declared by the command template itself
+var templateVars = map[string]bool{
+	"ctx": true, "cmd": true, "args": true, "err": true, "client": true,
+	"authToken": true, "outputFormat": true, "result": true, "output": true,
+	"hooks": true,
+}
 
-	result := s
-	// Replace acronyms temporarily with a placeholder
-	for i, acr := range acronyms {
-		placeholder := fmt.Sprintf("__ACR%d__", i)
-		result = strings.ReplaceAll(result, acr, placeholder)
-	}
+// paginationVars are the extra variables declared by the page fetching function
+var paginationVars = map[string]bool{"opts": true}
 
-	// Apply snake case
-	var snaked strings.Builder
-	for i, r := range result {
-		if i > 0 && r >= 'A' && r <= 'Z' {
-			snaked.WriteRune('_')
-		}
-		snaked.WriteRune(r)
-	}
-	result = strings.ToLower(snaked.String())
+// paginationFlags are the flags declared by addPaginationFlags
+var paginationFlags = map[string]bool{"page": true, "per-page": true, "limit": true, "all": true}
 
-	// Restore acronyms as lowercase
-	for i, acr := range acronyms {
-		placeholder := fmt.Sprintf("__acr%d__", i)
-		result = strings.ReplaceAll(result, placeholder, strings.ToLower(acr))
-	}
+// streamFlags are the flags declared by addStreamFlags
+var streamFlags = map[string]bool{"reconnect": true}
 
-	return strings.ReplaceAll(result, "_", "-")
-}
+// listFlags are the flags declared by addListFlags
+var listFlags = map[string]bool{"sort-by": true, "filter": true, "interactive": true}
+
+// listShorthands are the shorthands declared by addListFlags
+var listShorthands = map[string]bool{"i": true}
+
+// checkCommandNames reports flags, shorthands and variables that would be
+// declared twice in the generated command.
+func checkCommandNames(serviceName string, method Method, cmd CommandDef) []string {
+	scope := serviceName + "." + method.Name
 
-func toCamelCase(s string) string {
-	if s == "" {
-		return s
+	flags := newNameCollisions(scope, "flag")
+	flags.reserve(ReservedFlags, "a root flag")
+	if cmd.AutoPaginate {
+		flags.reserve(paginationFlags, "a pagination flag")
+	}
+	if cmd.ListFlags {
+		flags.reserve(listFlags, "a list flag")
+	}
+	if cmd.RendererType == "stream" {
+		flags.reserve(streamFlags, "a stream flag")
+	}
+	shorthands := newNameCollisions(scope, "shorthand")
+	shorthands.reserve(ReservedShorthands, "a root flag")
+	if cmd.ListFlags {
+		shorthands.reserve(listShorthands, "a list flag")
+	}
+	for _, f := range cmd.Flags {
+		flags.add(f.Name, f.Source)
+		shorthands.add(f.Shorthand, f.Source)
 	}
-	return strings.ToLower(s[:1]) + s[1:]
-}
 
-func toPascalCase(s string) string {
-	if s == "" {
-		return s
+	vars := newNameCollisions(scope, "variable")
+	vars.reserve(templateVars, "the command template")
+	if cmd.AutoPaginate {
+		vars.reserve(paginationVars, "the page fetching function")
+	}
+	for _, fv := range cmd.FlagVars {
+		vars.add(fv.Name, "flag --"+fv.FlagName)
+	}
+	for _, b := range cmd.StructBuilders {
+		vars.add(b.VarName, b.TypeName)
+	}
+	for _, chain := range cmd.ChainedCalls {
+		vars.add(chain.ResultVar, chain.MethodName)
 	}
-	// Convert kebab-case to PascalCase
-	parts := strings.Split(s, "-")
-	for i, part := range parts {
-		if len(part) > 0 {
-			parts[i] = strings.ToUpper(part[:1]) + part[1:]
+	for _, r := range cmd.Resolvers {
+		for _, suffix := range []string{"Selector", "List", "Items"} {
+			vars.add(r.Var+suffix, "resolver --"+r.SelectorFlag)
 		}
 	}
-	return strings.Join(parts, "")
+	if !cmd.AutoPaginate && len(cmd.RenderedResults) > 1 {
+		for _, r := range cmd.RenderedResults {
+			vars.add(r.Var, "result "+r.Name)
+		}
+	}
+
+	var collisions []string
+	collisions = append(collisions, flags.errors...)
+	collisions = append(collisions, shorthands.errors...)
+	return append(collisions, vars.errors...)
 }
@@ -0,0 +1,249 @@
+package generator
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update the golden files under testdata/golden")
+
+const (
+	fixtureSDKPath = "testdata/fixturesdk"
+	goldenPath     = "testdata/golden"
+)
+
+// assertGolden compares got with the named golden file, rewriting it when -update is set
+func assertGolden(t *testing.T, name string, got []byte) {
+	t.Helper()
+	path := filepath.Join(goldenPath, name+".golden")
+
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, got, 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read golden file (run go test ./generator -update to create it): %v", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("%s differs from %s (run go test ./generator -update to refresh it)\ngot:\n%s", name, path, got)
+	}
+}
+
+// parseFixture parses the fixture SDK the way the generate command does
+func parseFixture(t *testing.T) ([]Service, map[string]ParsedStruct) {
+	t.Helper()
+	services, structs, err := ParseSDKWithStructs(fixtureSDKPath)
+	if err != nil {
+		t.Fatalf("parse fixture SDK: %v", err)
+	}
+	return DetectMethodChaining(services, structs), structs
+}
+
+// fixtureCustom is the fixture method marked custom in the manifest
+const fixtureCustom = "WidgetsResize"
+
+// fixtureManifest builds the manifest update-manifest would write for the
+// fixture SDK, with fixtureCustom marked custom and the Widget columns set by hand
+func fixtureManifest(services []Service) *Manifest {
+	manifest := NewManifest()
+	manifest.AddServices(services)
+	manifest.EnsureParamNames()
+	manifest.Columns = map[string][]string{"Widget": {"name", "kind", "created_at"}}
+
+	svc := manifest.Services["WidgetsService"]
+	for i := range svc.Methods {
+		switch svc.Methods[i].Name {
+		case fixtureCustom:
+			svc.Methods[i].Custom = true
+		case "WidgetsSetVariables":
+			// Name the status code instead of the inferred "value"
+			svc.Methods[i].Results[1].Name = "status"
+		case "WidgetsRestart":
+			// Only render the widget
+			svc.Methods[i].Results[1].Render = false
+		}
+	}
+	return manifest
+}
+
+func TestParserGolden(t *testing.T) {
+	services, structs := parseFixture(t)
+
+	got, err := json.MarshalIndent(struct {
+		Services []Service
+		Structs  map[string]ParsedStruct
+	}{services, structs}, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertGolden(t, "parser.json", append(got, '\n'))
+}
+
+func TestManifestGolden(t *testing.T) {
+	services, _ := parseFixture(t)
+
+	path := filepath.Join(t.TempDir(), "manifest.toml")
+	if err := fixtureManifest(services).Save(path); err != nil {
+		t.Fatalf("save manifest: %v", err)
+	}
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertGolden(t, "manifest.toml", got)
+}
+
+// fixtureFiles renders the files generate would write for the fixture SDK
+func fixtureFiles(t *testing.T) GeneratedFiles {
+	t.Helper()
+	return fixtureFilesWith(t, DefaultTemplates())
+}
+
+// fixtureFilesWith renders the fixture SDK with templates
+func fixtureFilesWith(t *testing.T, templates *Templates) GeneratedFiles {
+	t.Helper()
+	methods, structs := fixtureMethods(t)
+	files, err := RenderCommands(methods, structs, templates)
+	if err != nil {
+		t.Fatalf("render commands: %v", err)
+	}
+	if files["spec.toml"], err = RenderSpec(methods); err != nil {
+		t.Fatalf("render spec: %v", err)
+	}
+	return files
+}
+
+// fixtureMethods returns the fixture SDK methods generate would render
+func fixtureMethods(t *testing.T) (map[string][]Method, map[string]ParsedStruct) {
+	t.Helper()
+	services, structs := parseFixture(t)
+	manifest := fixtureManifest(services)
+	generate := manifest.MethodsToGenerateSet()
+	custom := manifest.CustomMethodsSet()
+	results := manifest.MethodResults()
+	if err := CheckColumns(manifest.Columns, structs); err != nil {
+		t.Fatal(err)
+	}
+	ConfigureColumns(manifest.Columns)
+	t.Cleanup(func() { ConfigureColumns(nil) })
+
+	methods := make(map[string][]Method)
+	for _, svc := range services {
+		for _, method := range svc.Methods {
+			if generate[svc.Name+"."+method.Name] {
+				method.Custom = custom[svc.Name+"."+method.Name]
+				if r, ok := results[svc.Name+"."+method.Name]; ok {
+					if err := CheckResults(method, r); err != nil {
+						t.Fatal(err)
+					}
+					method.Results = r
+				}
+				methods[svc.Name] = append(methods[svc.Name], method)
+			}
+		}
+	}
+	return methods, structs
+}
+
+func TestGenerateGolden(t *testing.T) {
+	files := fixtureFiles(t)
+	for _, name := range files.Names() {
+		assertGolden(t, filepath.Join("commands", name), files[name])
+	}
+
+	// Golden files left over from a previous generation must be removed
+	goldens, err := filepath.Glob(filepath.Join(goldenPath, "commands", "*.golden"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, golden := range goldens {
+		name := strings.TrimSuffix(filepath.Base(golden), ".golden")
+		if _, ok := files[name]; ok {
+			continue
+		}
+		if *update {
+			if err := os.Remove(golden); err != nil {
+				t.Fatal(err)
+			}
+			continue
+		}
+		t.Errorf("%s is no longer generated (run go test ./generator -update to remove it)", golden)
+	}
+}
+
+func TestGenerateDeterministic(t *testing.T) {
+	first := fixtureFiles(t)
+	for i := 0; i < 5; i++ {
+		files := fixtureFiles(t)
+		for _, name := range first.Names() {
+			if !bytes.Equal(files[name], first[name]) {
+				t.Fatalf("%s differs between runs:\n%s", name, unifiedDiff("first", "next", first[name], files[name]))
+			}
+		}
+	}
+}
+
+func TestCheckFiles(t *testing.T) {
+	files := fixtureFiles(t)
+	outputPath := t.TempDir()
+	if _, err := WriteFiles(outputPath, files); err != nil {
+		t.Fatalf("write files: %v", err)
+	}
+
+	diff, err := CheckFiles(outputPath, files)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff != "" {
+		t.Fatalf("fresh output reported as drifted:\n%s", diff)
+	}
+	if changed, err := WriteFiles(outputPath, files); err != nil || len(changed) != 0 {
+		t.Fatalf("rewrite of unchanged files: changed %v, err %v", changed, err)
+	}
+
+	// Drift: an edited file, a deleted file and a stale generated file
+	edited := bytes.Replace(files["gadgets.go"], []byte(`Use:   "list"`), []byte(`Use:   "ls"`), 1)
+	writeTestFile(t, filepath.Join(outputPath, "gadgets.go"), edited)
+	if err := os.Remove(filepath.Join(outputPath, "register.go")); err != nil {
+		t.Fatal(err)
+	}
+	writeTestFile(t, filepath.Join(outputPath, "old.go"), []byte(generatedHeader+"\npackage commands\n"))
+	writeTestFile(t, filepath.Join(outputPath, "custom.go"), []byte("package commands\n"))
+
+	diff, err = CheckFiles(outputPath, files)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertGolden(t, "check.diff", []byte(strings.ReplaceAll(diff, strings.TrimPrefix(filepath.ToSlash(outputPath), "/"), "OUT")))
+
+	changed, err := WriteFiles(outputPath, files)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"gadgets.go", "register.go", "old.go"}; !reflect.DeepEqual(changed, want) {
+		t.Errorf("changed files = %v, want %v", changed, want)
+	}
+	if _, err := os.Stat(filepath.Join(outputPath, "custom.go")); err != nil {
+		t.Errorf("hand-written file removed: %v", err)
+	}
+}
+
+func writeTestFile(t *testing.T, path string, content []byte) {
+	t.Helper()
+	if err := os.WriteFile(path, content, 0644); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -1,16 +1,26 @@
 package generator
 
 import (
+	"fmt"
+	"maps"
 	"os"
+	"slices"
+	"strings"
 
 	"github.com/BurntSushi/toml"
 )
 
 // Manifest tracks known SDK methods and their generation status
 type Manifest struct {
-	Version    int                        `toml:"version"`
-	SDKVersion string                     `toml:"sdk_version"`
-	Services   map[string]ManifestService `toml:"services"`
+	Version    int    `toml:"version"`
+	SDKVersion string `toml:"sdk_version"`
+	// Acronyms lists extra initialisms to keep together when deriving names
+	// (e.g., "SMTP" so that "SMTPURL" becomes "smtp-url" rather than "smtpurl")
+	Acronyms []string `toml:"acronyms,omitempty"`
+	// Columns overrides the default table columns by SDK type name (e.g.,
+	// App = ["name", "status"]), dotted for nested fields
+	Columns  map[string][]string        `toml:"columns,omitempty"`
+	Services map[string]ManifestService `toml:"services"`
 }
 
 // ManifestService represents a service in the manifest
@@ -50,6 +60,17 @@ type ManifestMethod struct {
 	Params    []ManifestParam `toml:"params"`
 	Returns   string          `toml:"returns"`
 	Generated bool            `toml:"generated"`
+	Custom    bool            `toml:"custom,omitempty"` // Hand-written command, the generator only leaves a stub
+	// Results names the non-error returns of methods returning several values,
+	// and selects the ones rendered
+	Results []ManifestResult `toml:"results,omitempty"`
+}
+
+// ManifestResult is a named return value of a manifest method
+type ManifestResult struct {
+	Name   string `toml:"name"`
+	Type   string `toml:"type"`
+	Render bool   `toml:"render"`
 }
 
 // NewManifest creates a new empty manifest
@@ -124,6 +145,7 @@ func (m *Manifest) AddServices(services []Service) {
 					Params:    params,
 					Returns:   returns,
 					Generated: true,
+					Results:   manifestResults(method),
 				})
 			}
 		}
@@ -132,12 +154,12 @@ func (m *Manifest) AddServices(services []Service) {
 }
 
 // MethodsToGenerate converts the manifest into generator Methods, keeping only
-// entries marked as Generated.
+// entries marked as Generated or Custom.
 func (m *Manifest) MethodsToGenerate() map[string][]Method {
 	result := make(map[string][]Method)
 	for serviceName, svc := range m.Services {
 		for _, method := range svc.Methods {
-			if !method.Generated {
+			if !method.Generated && !method.Custom {
 				continue
 			}
 			result[serviceName] = append(result[serviceName], method.toMethod())
@@ -147,12 +169,145 @@ func (m *Manifest) MethodsToGenerate() map[string][]Method {
 }
 
 // MethodsToGenerateSet returns a set of "ServiceName.MethodName" keys for methods
-// marked as Generated. This is used to filter parsed SDK methods.
+// marked as Generated or Custom (which get a stub). This is used to filter
+// parsed SDK methods.
 func (m *Manifest) MethodsToGenerateSet() map[string]bool {
 	result := make(map[string]bool)
 	for serviceName, svc := range m.Services {
 		for _, method := range svc.Methods {
-			if method.Generated {
+			if method.Generated || method.Custom {
+				result[serviceName+"."+method.Name] = true
+			}
+		}
+	}
+	return result
+}
+
+// MethodResults returns the results declared in the manifest by
+// "ServiceName.MethodName" key, for the methods declaring some
+func (m *Manifest) MethodResults() map[string][]Result {
+	result := make(map[string][]Result)
+	for serviceName, svc := range m.Services {
+		for _, method := range svc.Methods {
+			if len(method.Results) > 0 {
+				result[serviceName+"."+method.Name] = method.toMethod().Results
+			}
+		}
+	}
+	return result
+}
+
+// CheckResults reports manifest results that don't match the non-error returns
+// of the SDK method, in number or type, or that share a name
+func CheckResults(method Method, results []Result) error {
+	var returns []string
+	for _, ret := range method.Returns {
+		if !ret.IsError {
+			returns = append(returns, ret.Type)
+		}
+	}
+	var types []string
+	seen := make(map[string]bool)
+	for _, r := range results {
+		if r.Name == "" || seen[r.Name] {
+			return fmt.Errorf("results of %s must have distinct non-empty names", method.Name)
+		}
+		seen[r.Name] = true
+		types = append(types, r.Type)
+	}
+	if !slices.Equal(types, returns) {
+		return fmt.Errorf("results of %s are (%s) in the manifest but the SDK returns (%s)",
+			method.Name, strings.Join(types, ", "), strings.Join(returns, ", "))
+	}
+	return nil
+}
+
+// CheckColumns checks that the column overrides of the manifest name SDK
+// structs and, as far as the parsed structs go, their fields
+func CheckColumns(columns map[string][]string, structs map[string]ParsedStruct) error {
+	for _, typeName := range slices.Sorted(maps.Keys(columns)) {
+		if _, ok := structs[typeName]; !ok {
+			return fmt.Errorf("columns of %s: no such SDK struct", typeName)
+		}
+		for _, column := range columns[typeName] {
+			if err := checkColumn(typeName, column, structs); err != nil {
+				return fmt.Errorf("columns of %s: %w", typeName, err)
+			}
+		}
+	}
+	return nil
+}
+
+// checkColumn checks a dotted column path of typeName. Fields of types that
+// weren't parsed, such as time.Time, can't be checked and are accepted.
+func checkColumn(typeName, column string, structs map[string]ParsedStruct) error {
+	for _, segment := range strings.Split(column, ".") {
+		ps, ok := structs[strings.TrimPrefix(typeName, "*")]
+		if !ok {
+			return nil
+		}
+		field, found, checked := findColumnField(ps, segment, structs)
+		if !checked {
+			return nil
+		}
+		if !found {
+			return fmt.Errorf("%s has no field %q", ps.Name, segment)
+		}
+		typeName = field.Type
+	}
+	return nil
+}
+
+// findColumnField returns the field of ps matching a column segment by name
+// or JSON tag, ignoring case, "_" and "-" as the renderer does. Like the
+// renderer, it looks into embedded structs when ps has no such field. checked
+// is false when an embedded struct wasn't parsed: the field may be one of its.
+func findColumnField(ps ParsedStruct, segment string, structs map[string]ParsedStruct) (field StructField, found, checked bool) {
+	fold := func(s string) string {
+		return strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(s))
+	}
+	for _, field := range slices.Concat(ps.Fields, ps.Embedded) {
+		if fold(field.Name) == fold(segment) || field.JSONTag != "" && fold(field.JSONTag) == fold(segment) {
+			return field, true, true
+		}
+	}
+	checked = true
+	for _, embedded := range ps.Embedded {
+		eps, ok := structs[strings.TrimPrefix(embedded.Type, "*")]
+		if !ok {
+			checked = false
+			continue
+		}
+		field, found, ok := findColumnField(eps, segment, structs)
+		if found {
+			return field, true, true
+		}
+		checked = checked && ok
+	}
+	return StructField{}, false, checked
+}
+
+// manifestResults returns the results written to the manifest for a method,
+// only for methods returning several values
+func manifestResults(method Method) []ManifestResult {
+	results := DefaultResults(method)
+	if len(results) < 2 {
+		return nil
+	}
+	mrs := make([]ManifestResult, len(results))
+	for i, r := range results {
+		mrs[i] = ManifestResult{Name: r.Name, Type: r.Type, Render: r.Render}
+	}
+	return mrs
+}
+
+// CustomMethodsSet returns a set of "ServiceName.MethodName" keys for methods
+// marked as Custom
+func (m *Manifest) CustomMethodsSet() map[string]bool {
+	result := make(map[string]bool)
+	for serviceName, svc := range m.Services {
+		for _, method := range svc.Methods {
+			if method.Custom {
 				result[serviceName+"."+method.Name] = true
 			}
 		}
@@ -201,9 +356,16 @@ func (m ManifestMethod) toMethod() Method {
 	// downstream renderer inference logic intact.
 	returns = append(returns, Return{Type: "error", IsError: true})
 
+	var results []Result
+	for _, r := range m.Results {
+		results = append(results, Result{Name: r.Name, Type: r.Type, Render: r.Render})
+	}
+
 	return Method{
 		Name:    m.Name,
 		Params:  params,
 		Returns: returns,
+		Custom:  m.Custom,
+		Results: results,
 	}
 }
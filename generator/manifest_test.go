@@ -0,0 +1,50 @@
+package generator
+
+import "testing"
+
+func TestCheckColumns(t *testing.T) {
+	structs := map[string]ParsedStruct{
+		"Owner": {Name: "Owner", Fields: []StructField{{Name: "Username", Type: "string", JSONTag: "username"}}},
+		"App": {
+			Name:     "App",
+			Fields:   []StructField{{Name: "Name", Type: "string", JSONTag: "name"}, {Name: "Owner", Type: "*Owner", JSONTag: "owner"}},
+			Embedded: []StructField{{Name: "Timestamps", Type: "Timestamps"}},
+		},
+		"Timestamps": {Name: "Timestamps", Fields: []StructField{{Name: "CreatedAt", Type: "time.Time", JSONTag: "created_at"}}},
+		"AppWithStatus": {
+			Name:     "AppWithStatus",
+			Fields:   []StructField{{Name: "Status", Type: "string", JSONTag: "status"}},
+			Embedded: []StructField{{Name: "App", Type: "*App"}, {Name: "Model", Type: "gorm.Model"}},
+		},
+	}
+
+	tests := []struct {
+		typeName string
+		column   string
+		wantErr  string
+	}{
+		{typeName: "App", column: "name"},
+		{typeName: "App", column: "owner.username"},
+		{typeName: "App", column: "Owner.User_Name"},
+		{typeName: "App", column: "created_at"},
+		{typeName: "App", column: "timestamps.created_at"},
+		{typeName: "AppWithStatus", column: "owner.username"},
+		{typeName: "AppWithStatus", column: "app.name"},
+		{typeName: "AppWithStatus", column: "deleted_at"}, // Maybe a field of gorm.Model
+		{typeName: "App", column: "created_at.unix"},
+		{typeName: "App", column: "owner.email", wantErr: `columns of App: Owner has no field "email"`},
+		{typeName: "App", column: "status", wantErr: `columns of App: App has no field "status"`},
+		{typeName: "Variable", wantErr: "columns of Variable: no such SDK struct"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.typeName+" "+tt.column, func(t *testing.T) {
+			err := CheckColumns(map[string][]string{tt.typeName: {tt.column}}, structs)
+			if tt.wantErr == "" && err != nil {
+				t.Fatal(err)
+			}
+			if tt.wantErr != "" && (err == nil || err.Error() != tt.wantErr) {
+				t.Fatalf("error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,258 @@
+package generator
+
+import (
+	"fmt"
+	"go/token"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// DefaultAcronyms lists the initialisms recognised when splitting identifiers.
+// Additional acronyms can be declared in the manifest.
+var DefaultAcronyms = []string{
+	"ACL", "API", "CPU", "CSV", "DB", "DNS", "HTML", "HTTP", "HTTPS", "ID", "IP",
+	"JSON", "OS", "RAM", "SCM", "SQL", "SSH", "SSL", "TCP", "TLS", "TTL", "UDP",
+	"UI", "URI", "URL", "UUID", "VPN", "XML",
+}
+
+// ReservedFlags are the persistent flags declared on the runtime root command.
+// Generated commands must not declare a local flag with the same name.
+var ReservedFlags = map[string]bool{
+	"app":           true,
+	"region":        true,
+	"output":        true,
+	"columns":       true,
+	"wide":          true,
+	"depth":         true,
+	"query":         true,
+	"template-file": true,
+	"color":         true,
+	"no-color":      true,
+	"no-pager":      true,
+	"api-token":     true,
+	"config":        true,
+	"timeout":       true,
+	"help":          true,
+}
+
+// ReservedShorthands are the shorthands used by the runtime root flags
+var ReservedShorthands = map[string]bool{
+	"a": true,
+	"o": true,
+	"h": true,
+}
+
+// Namer derives command, flag, variable and file names from SDK identifiers.
+// Identifiers are split into words on case changes and known initialisms, so
+// "StackID" becomes "stack-id" rather than "stack-i-d".
+type Namer struct {
+	acronyms map[string]bool
+}
+
+// NewNamer creates a namer recognising the given acronyms
+func NewNamer(acronyms []string) *Namer {
+	n := &Namer{acronyms: make(map[string]bool)}
+	for _, acr := range acronyms {
+		if acr != "" {
+			n.acronyms[strings.ToUpper(acr)] = true
+		}
+	}
+	return n
+}
+
+// names is the namer used by the generator
+var names = NewNamer(DefaultAcronyms)
+
+// ConfigureNaming sets the acronyms recognised by the generator, in addition to
+// DefaultAcronyms.
+func ConfigureNaming(extraAcronyms []string) {
+	names = NewNamer(append(append([]string{}, DefaultAcronyms...), extraAcronyms...))
+}
+
+// Split splits an identifier into words. Runs of capitals are kept together
+// ("SCMRepoLink" -> SCM, Repo, Link) and split further when they are made of
+// several known acronyms ("APIURL" -> API, URL).
+func (n *Namer) Split(s string) []string {
+	var words []string
+	for _, chunk := range strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		for _, word := range splitCase(chunk) {
+			words = append(words, n.splitAcronyms(word)...)
+		}
+	}
+	return words
+}
+
+// Command returns the kebab-case name used for commands (e.g., "deployment-list")
+func (n *Namer) Command(s string) string {
+	return n.join(s, "-")
+}
+
+// Flag returns the kebab-case name used for flags (e.g., "addon-id")
+func (n *Namer) Flag(s string) string {
+	return n.join(s, "-")
+}
+
+// legacyCommand returns the name commands had before the Namer, each capital
+// starting a word (e.g., "s_c_m_integrations" for SCMIntegrations and sep "_").
+// Generated commands keep it as an alias when it differs from their name.
+func legacyCommand(s, sep string) string {
+	var b strings.Builder
+	for i, r := range s {
+		if i > 0 && unicode.IsUpper(r) {
+			b.WriteString(sep)
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+// File returns the snake_case base name used for generated files (e.g., "scm_repo_link")
+func (n *Namer) File(s string) string {
+	return n.join(s, "_")
+}
+
+// Var returns the lowerCamelCase name used for Go variables (e.g., "addonID").
+// Names clashing with Go keywords get an "Arg" suffix.
+func (n *Namer) Var(s string) string {
+	words := n.Split(s)
+	if len(words) == 0 {
+		return s
+	}
+	var sb strings.Builder
+	sb.WriteString(strings.ToLower(words[0]))
+	for _, w := range words[1:] {
+		sb.WriteString(n.title(w))
+	}
+	v := sb.String()
+	if token.IsKeyword(v) {
+		v += "Arg"
+	}
+	return v
+}
+
+// Pascal returns the UpperCamelCase form of an identifier (e.g., "DeployURL")
+func (n *Namer) Pascal(s string) string {
+	var sb strings.Builder
+	for _, w := range n.Split(s) {
+		sb.WriteString(n.title(w))
+	}
+	return sb.String()
+}
+
+func (n *Namer) join(s, sep string) string {
+	words := n.Split(s)
+	for i, w := range words {
+		words[i] = strings.ToLower(w)
+	}
+	return strings.Join(words, sep)
+}
+
+// title capitalizes a word, upper-casing it entirely when it is an acronym
+func (n *Namer) title(w string) string {
+	upper := strings.ToUpper(w)
+	if n.acronyms[upper] {
+		return upper
+	}
+	// Plural acronyms (e.g., "ids" -> "IDs")
+	if strings.HasSuffix(w, "s") && n.acronyms[strings.ToUpper(w[:len(w)-1])] {
+		return strings.ToUpper(w[:len(w)-1]) + "s"
+	}
+	return strings.ToUpper(w[:1]) + strings.ToLower(w[1:])
+}
+
+// splitAcronyms splits an all-caps word made of several known acronyms
+func (n *Namer) splitAcronyms(word string) []string {
+	if len(word) < 2 || strings.ToUpper(word) != word || n.acronyms[word] {
+		return []string{word}
+	}
+
+	// Greedy longest-match; give up if the word isn't fully made of acronyms
+	var parts []string
+	rest := word
+	for rest != "" {
+		match := ""
+		for i := len(rest); i > 0; i-- {
+			if n.acronyms[rest[:i]] {
+				match = rest[:i]
+				break
+			}
+		}
+		if match == "" {
+			return []string{word}
+		}
+		parts = append(parts, match)
+		rest = rest[len(match):]
+	}
+	return parts
+}
+
+// splitCase splits a single identifier chunk on case changes
+func splitCase(s string) []string {
+	runes := []rune(s)
+	var words []string
+	start := 0
+	for i := 1; i < len(runes); i++ {
+		prev, cur := runes[i-1], runes[i]
+		boundary := false
+		switch {
+		case unicode.IsUpper(cur) && (unicode.IsLower(prev) || unicode.IsDigit(prev)):
+			// "stackID" -> stack | ID
+			boundary = true
+		case unicode.IsUpper(cur) && unicode.IsUpper(prev) && i+1 < len(runes) && unicode.IsLower(runes[i+1]):
+			// "SCMRepo" -> SCM | Repo, but keep plural acronyms like "IDs" together
+			boundary = !(runes[i+1] == 's' && (i+2 == len(runes) || !unicode.IsLower(runes[i+2])))
+		}
+		if boundary {
+			words = append(words, string(runes[start:i]))
+			start = i
+		}
+	}
+	if start < len(runes) {
+		words = append(words, string(runes[start:]))
+	}
+	return words
+}
+
+// nameCollisions records generated names and the identifiers they came from,
+// reporting any name derived from two different sources.
+type nameCollisions struct {
+	scope  string
+	kind   string
+	seen   map[string]string
+	errors []string
+}
+
+func newNameCollisions(scope, kind string) *nameCollisions {
+	return &nameCollisions{scope: scope, kind: kind, seen: make(map[string]string)}
+}
+
+// add records name as derived from source
+func (c *nameCollisions) add(name, source string) {
+	if name == "" {
+		return
+	}
+	if prev, ok := c.seen[name]; ok {
+		c.errors = append(c.errors, fmt.Sprintf("%s: %s %q generated for both %s and %s", c.scope, c.kind, name, prev, source))
+		return
+	}
+	c.seen[name] = source
+}
+
+// reserve marks name as already taken by source
+func (c *nameCollisions) reserve(names map[string]bool, source string) {
+	for name := range names {
+		c.seen[name] = source
+	}
+}
+
+// collisionError builds a single error out of the collisions found
+func collisionError(collisions []string) error {
+	if len(collisions) == 0 {
+		return nil
+	}
+	sort.Strings(collisions)
+	return fmt.Errorf("name collisions detected:\n  %s", strings.Join(collisions, "\n  "))
+}
@@ -0,0 +1,81 @@
+package generator
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNamerSplit(t *testing.T) {
+	namer := NewNamer(append([]string{"SMTP"}, DefaultAcronyms...))
+
+	tests := map[string][]string{
+		"stackID":             {"stack", "ID"},
+		"SCMRepoLink":         {"SCM", "Repo", "Link"},
+		"APIURL":              {"API", "URL"},
+		"SMTPURL":             {"SMTP", "URL"},
+		"addonIDs":            {"addon", "IDs"},
+		"IDs":                 {"IDs"},
+		"s3Bucket":            {"s3", "Bucket"},
+		"scalingo22Stack":     {"scalingo22", "Stack"},
+		"v2Token":             {"v2", "Token"},
+		"log_drain-url":       {"log", "drain", "url"},
+		"DatabaseMaintenance": {"Database", "Maintenance"},
+	}
+	for s, want := range tests {
+		t.Run(s, func(t *testing.T) {
+			if got := namer.Split(s); !reflect.DeepEqual(got, want) {
+				t.Errorf("Split(%q) = %q, want %q", s, got, want)
+			}
+		})
+	}
+}
+
+func TestNamerFlagAndVar(t *testing.T) {
+	tests := []struct {
+		acronyms []string
+		in       string
+		flag     string
+		varName  string
+	}{
+		{in: "StackID", flag: "stack-id", varName: "stackID"},
+		{in: "SCMRepoLink", flag: "scm-repo-link", varName: "scmRepoLink"},
+		{in: "APIURL", flag: "api-url", varName: "apiURL"},
+		{in: "addonIDs", flag: "addon-ids", varName: "addonIDs"},
+		{in: "pullRequestID", flag: "pull-request-id", varName: "pullRequestID"},
+		{in: "SMTPURL", flag: "smtpurl", varName: "smtpurl"},
+		{acronyms: []string{"SMTP"}, in: "SMTPURL", flag: "smtp-url", varName: "smtpURL"},
+		{acronyms: []string{"smtp"}, in: "smtp_host", flag: "smtp-host", varName: "smtpHost"},
+		{acronyms: []string{"SMTP"}, in: "mailSMTP", flag: "mail-smtp", varName: "mailSMTP"},
+		{in: "s3Bucket", flag: "s3-bucket", varName: "s3Bucket"},
+		{in: "scalingo22Stack", flag: "scalingo22-stack", varName: "scalingo22Stack"},
+		{in: "type", flag: "type", varName: "typeArg"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			namer := NewNamer(append(tt.acronyms, DefaultAcronyms...))
+
+			if got := namer.Flag(tt.in); got != tt.flag {
+				t.Errorf("Flag(%q) = %q, want %q", tt.in, got, tt.flag)
+			}
+			if got := namer.Var(tt.in); got != tt.varName {
+				t.Errorf("Var(%q) = %q, want %q", tt.in, got, tt.varName)
+			}
+		})
+	}
+}
+
+func TestLegacyCommand(t *testing.T) {
+	tests := []struct {
+		in, sep, want string
+	}{
+		{in: "SCMIntegrations", sep: "_", want: "s_c_m_integrations"},
+		{in: "CronTasks", sep: "_", want: "cron_tasks"},
+		{in: "ForceHTTPS", sep: "-", want: "force-h-t-t-p-s"},
+		{in: "List", sep: "-", want: "list"},
+	}
+	for _, tt := range tests {
+		if got := legacyCommand(tt.in, tt.sep); got != tt.want {
+			t.Errorf("legacyCommand(%q, %q) = %q, want %q", tt.in, tt.sep, got, tt.want)
+		}
+	}
+}
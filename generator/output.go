@@ -0,0 +1,171 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// generatedHeader starts every Go file written by the generator
+const generatedHeader = "// Code generated by generative-cli. DO NOT EDIT."
+
+// GeneratedFiles maps file names in the output directory to their content
+type GeneratedFiles map[string][]byte
+
+// Names returns the file names in sorted order
+func (f GeneratedFiles) Names() []string {
+	fileNames := make([]string, 0, len(f))
+	for name := range f {
+		fileNames = append(fileNames, name)
+	}
+	sort.Strings(fileNames)
+	return fileNames
+}
+
+// executeTemplate executes tmpl with data, returning the unformatted output
+func executeTemplate(tmpl *template.Template, data any) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("failed to execute %s template: %w", tmpl.Name(), err)
+	}
+	return buf.Bytes(), nil
+}
+
+// formatFiles runs gofmt on the Go files. Files that fail to format are left
+// unchanged and their syntax errors are returned.
+func formatFiles(files GeneratedFiles, origins map[string]string) CodeErrors {
+	var errs CodeErrors
+	for _, name := range files.Names() {
+		if filepath.Ext(name) != ".go" {
+			continue
+		}
+		formatted, err := format.Source(files[name])
+		if err != nil {
+			errs = append(errs, syntaxErrors(name, files[name], err, origins)...)
+			continue
+		}
+		files[name] = formatted
+	}
+	return errs
+}
+
+// WriteFiles writes files to outputPath, skipping the ones whose content didn't
+// change, and removes the generated Go files that are no longer produced. It
+// returns the names of the files written or removed.
+func WriteFiles(outputPath string, files GeneratedFiles) ([]string, error) {
+	if err := os.MkdirAll(outputPath, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create output directory: %w", err)
+	}
+
+	var changed []string
+	for _, name := range files.Names() {
+		written, err := writeFileIfChanged(filepath.Join(outputPath, name), files[name])
+		if err != nil {
+			return changed, err
+		}
+		if written {
+			changed = append(changed, name)
+		}
+	}
+
+	stale, err := staleFiles(outputPath, files)
+	if err != nil {
+		return changed, err
+	}
+	for _, name := range stale {
+		if err := os.Remove(filepath.Join(outputPath, name)); err != nil {
+			return changed, fmt.Errorf("failed to remove %s: %w", name, err)
+		}
+		changed = append(changed, name)
+	}
+
+	return changed, nil
+}
+
+// CheckFiles compares files with the content of outputPath. It returns a
+// unified diff from the current content to files, empty when outputPath is up
+// to date.
+func CheckFiles(outputPath string, files GeneratedFiles) (string, error) {
+	var sb strings.Builder
+	for _, name := range files.Names() {
+		path := filepath.Join(outputPath, name)
+		current, err := os.ReadFile(path)
+		if err != nil && !os.IsNotExist(err) {
+			return "", fmt.Errorf("failed to read %s: %w", path, err)
+		}
+
+		oldName := diffPath("a", path)
+		if os.IsNotExist(err) {
+			oldName = "/dev/null"
+		}
+		sb.WriteString(unifiedDiff(oldName, diffPath("b", path), current, files[name]))
+	}
+
+	stale, err := staleFiles(outputPath, files)
+	if err != nil {
+		return "", err
+	}
+	for _, name := range stale {
+		path := filepath.Join(outputPath, name)
+		current, err := os.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		sb.WriteString(unifiedDiff(diffPath("a", path), "/dev/null", current, nil))
+	}
+
+	return sb.String(), nil
+}
+
+// diffPath returns the name of path in a diff header (e.g., "a/generated/commands/apps.go")
+func diffPath(side, path string) string {
+	return side + "/" + strings.TrimPrefix(filepath.ToSlash(path), "/")
+}
+
+// writeFileIfChanged writes content to path unless the file already holds it
+func writeFileIfChanged(path string, content []byte) (bool, error) {
+	current, err := os.ReadFile(path)
+	if err == nil && bytes.Equal(current, content) {
+		return false, nil
+	}
+	if err := os.WriteFile(path, content, 0644); err != nil {
+		return false, fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return true, nil
+}
+
+// staleFiles lists the generated Go files in outputPath that are not part of files
+func staleFiles(outputPath string, files GeneratedFiles) ([]string, error) {
+	entries, err := os.ReadDir(outputPath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read output directory: %w", err)
+	}
+
+	var stale []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") {
+			continue
+		}
+		if _, ok := files[name]; ok {
+			continue
+		}
+		content, err := os.ReadFile(filepath.Join(outputPath, name))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", name, err)
+		}
+		// Hand-written files in the output directory are left alone
+		if bytes.HasPrefix(content, []byte(generatedHeader)) {
+			stale = append(stale, name)
+		}
+	}
+	return stale, nil
+}
@@ -70,6 +70,7 @@ func ParseSDK(sdkPath string) ([]Service, error) {
 		}
 	}
 
+	sortServices(services)
 	return services, nil
 }
 
@@ -136,9 +137,18 @@ func ParseSDKWithStructs(sdkPath string) ([]Service, map[string]ParsedStruct, er
 		}
 	}
 
+	sortServices(services)
 	return services, structs, nil
 }
 
+// sortServices orders services by name so the result doesn't depend on the
+// order the SDK files were read in
+func sortServices(services []Service) {
+	sort.SliceStable(services, func(i, j int) bool {
+		return services[i].Name < services[j].Name
+	})
+}
+
 // IsExpandableParam checks if a parameter type should be expanded into individual CLI flags
 // This includes types ending in Opts, Options, or Params
 func IsExpandableParam(paramType string, structs map[string]ParsedStruct) bool {
@@ -188,6 +198,65 @@ func GetPrimaryReturnType(method Method) string {
 	return ""
 }
 
+// DefaultResults names the non-error returns of a method: the name from the
+// SDK signature, or one inferred from the type (see inferResultName, "value"
+// for builtin types). Every result is rendered but PaginationMeta,
+// shown in the footer of paginated commands.
+func DefaultResults(method Method) []Result {
+	var results []Result
+	seen := make(map[string]int)
+	for _, ret := range method.Returns {
+		if ret.IsError {
+			continue
+		}
+		name := ret.Name
+		if name == "" {
+			name = inferResultName(ret.Type)
+		}
+		seen[name]++
+		if seen[name] > 1 {
+			name = fmt.Sprintf("%s%d", name, seen[name])
+		}
+		results = append(results, Result{Name: name, Type: ret.Type, Render: ret.Type != "PaginationMeta"})
+	}
+	return results
+}
+
+// inferResultName derives the name of an unnamed return value from its type:
+// "widget" for *Widget, "widgets" for []*Widget, "meta" for PaginationMeta
+func inferResultName(typ string) string {
+	base := strings.TrimLeft(typ, "[]*")
+	if idx := strings.LastIndex(base, "."); idx != -1 {
+		base = base[idx+1:]
+	}
+	switch {
+	case builtinTypes[base] || strings.HasPrefix(base, "map[") || base == "":
+		return "value"
+	case base == "PaginationMeta":
+		return "meta"
+	}
+	name := names.Var(base)
+	if strings.HasPrefix(typ, "[]") && !strings.HasSuffix(name, "s") {
+		name += "s"
+	}
+	return name
+}
+
+// IsStreamingMethod reports whether a method returns a long-lived stream, read
+// as it arrives and reconnected when it drops: a websocket, or the HTTP
+// response of a logs or stream method. Other HTTP responses (e.g. AppsRestart)
+// are read once, as connecting again would repeat the action.
+func IsStreamingMethod(method Method) bool {
+	switch GetPrimaryReturnType(method) {
+	case "*websocket.Conn":
+		return true
+	case "*http.Response":
+		return !strings.HasSuffix(method.Name, "URL") &&
+			(strings.Contains(method.Name, "Logs") || strings.Contains(method.Name, "Stream"))
+	}
+	return false
+}
+
 // InferRendererType determines the appropriate renderer based on return type
 func InferRendererType(returnType string) string {
 	switch {
@@ -197,6 +266,8 @@ func InferRendererType(returnType string) string {
 		return "table"
 	case returnType == "*http.Response":
 		return "http"
+	case returnType == "*websocket.Conn":
+		return "stream"
 	default:
 		return "detail"
 	}
@@ -259,10 +330,13 @@ func parseInterface(name string, iface *ast.InterfaceType) Service {
 		if funcType.Results != nil {
 			for _, result := range funcType.Results.List {
 				retType := typeToString(result.Type)
-				m.Returns = append(m.Returns, Return{
-					Type:    retType,
-					IsError: retType == "error",
-				})
+				if len(result.Names) == 0 {
+					m.Returns = append(m.Returns, Return{Type: retType, IsError: retType == "error"})
+				}
+				// Named results can share a type (e.g., "status, code int")
+				for _, name := range result.Names {
+					m.Returns = append(m.Returns, Return{Name: name.Name, Type: retType, IsError: retType == "error"})
+				}
 			}
 		}
 
@@ -283,7 +357,13 @@ func parseStruct(name string, st *ast.StructType) ParsedStruct {
 
 	for _, field := range st.Fields.List {
 		if len(field.Names) == 0 {
-			continue // embedded field
+			typ := typeToString(field.Type)
+			name := strings.TrimPrefix(typ, "*")
+			if idx := strings.LastIndex(name, "."); idx != -1 {
+				name = name[idx+1:]
+			}
+			ps.Embedded = append(ps.Embedded, StructField{Name: name, Type: typ})
+			continue
 		}
 
 		sf := StructField{
@@ -433,13 +513,15 @@ func countInterfaceMethods(iface *ast.InterfaceType) int {
 }
 
 // DetectMethodChaining analyzes services to find parameters that can be auto-fetched
-// from other methods in the same service. This enables automatic chaining like:
+// from other methods. This enables automatic chaining like:
 // logsURL param -> automatically call LogsURL(app) first
-func DetectMethodChaining(services []Service) []Service {
+// and resolving IDs by listing the identified type (see detectResolvers).
+// Apps named "name" are read from --app too (see detectAppParams).
+func DetectMethodChaining(services []Service, structs map[string]ParsedStruct) []Service {
 	for i := range services {
-		services[i] = detectServiceChaining(services[i])
+		services[i] = detectServiceChaining(detectAppParams(services[i]))
 	}
-	return services
+	return detectResolvers(services, structs)
 }
 
 // detectServiceChaining finds chainable parameters within a single service
@@ -450,9 +532,6 @@ func detectServiceChaining(service Service) Service {
 		methodsByName[service.Methods[i].Name] = &service.Methods[i]
 	}
 
-	// Track which methods should be hidden (used only for chaining)
-	hiddenMethods := make(map[string]bool)
-
 	// Check each method's parameters for chainable patterns
 	for i := range service.Methods {
 		method := &service.Methods[i]
@@ -465,21 +544,31 @@ func detectServiceChaining(service Service) Service {
 				param.ChainedFrom = &ChainedParam{
 					MethodName:   chainedMethod.Name,
 					SourceParams: chainedMethod.Params,
+					ReturnType:   GetPrimaryReturnType(*chainedMethod),
 				}
-				hiddenMethods[chainedMethod.Name] = true
 				fmt.Printf("  -> Detected chaining: %s.%s param '%s' from method %s\n",
 					service.Name, method.Name, param.Name, chainedMethod.Name)
 			}
 		}
 	}
 
-	// Mark hidden methods
+	return service
+}
+
+// detectAppParams renames to "app" the first param of the AppsService methods
+// naming the app they act on "name" (e.g., AppsDestroy(ctx, name, currentName)),
+// so the app comes from --app like the app param of the other methods
+func detectAppParams(service Service) Service {
+	if service.Name != "AppsService" {
+		return service
+	}
 	for i := range service.Methods {
-		if hiddenMethods[service.Methods[i].Name] {
-			service.Methods[i].Hidden = true
+		method := &service.Methods[i]
+		if len(method.Params) > 0 && method.Params[0].Name == "name" && method.Params[0].Type == "string" {
+			method.Params[0].Name = "app"
+			fmt.Printf("  -> Detected app param: %s.%s param 'name' from --app\n", service.Name, method.Name)
 		}
 	}
-
 	return service
 }
 
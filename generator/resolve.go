@@ -0,0 +1,232 @@
+package generator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// detectResolvers finds the ID parameters that can be resolved by calling a
+// list method returning the identified type, in the same or another service.
+// For example the deploy param of Deployment(ctx, app, deploy) is the ID of a
+// Deployment, and DeploymentList(ctx, app) lists them given the app the user
+// already provides.
+func detectResolvers(services []Service, structs map[string]ParsedStruct) []Service {
+	providers := listProviders(services, structs)
+
+	for i := range services {
+		service := &services[i]
+		for j := range service.Methods {
+			method := &service.Methods[j]
+			for k := range method.Params {
+				param := &method.Params[k]
+				if param.Type != "string" || param.ChainedFrom != nil {
+					continue
+				}
+				if _, ok := sharedParams[param.Name]; ok {
+					continue
+				}
+
+				typ := identifiedType(*param, *method, *service, providers)
+				if typ == "" {
+					continue
+				}
+				resolved := findProvider(typ, *method, service.Name, providers[typ])
+				if resolved == nil {
+					continue
+				}
+				resolved.CreatedAt = createdAtType(structs[typ])
+				resolved.NameFields = nameFields(structs[typ])
+				param.ResolvedFrom = resolved
+				fmt.Printf("  -> Detected resolver: %s.%s param '%s' (%s ID) from %s.%s\n",
+					service.Name, method.Name, param.Name, typ, resolved.Service, resolved.MethodName)
+			}
+		}
+	}
+	return services
+}
+
+// listProvider is a method listing a type with an ID
+type listProvider struct {
+	service string
+	method  Method
+}
+
+// listProviders maps the types with a string ID field to the methods listing
+// them. Paginated methods are left out.
+func listProviders(services []Service, structs map[string]ParsedStruct) map[string][]listProvider {
+	providers := make(map[string][]listProvider)
+	for _, service := range services {
+		for _, method := range service.Methods {
+			if SupportsPagination(method) || HasPaginationMeta(method) {
+				continue
+			}
+			ret := GetPrimaryReturnType(method)
+			if !strings.HasPrefix(ret, "[]") {
+				continue
+			}
+			typ := strings.TrimPrefix(strings.TrimPrefix(ret, "[]"), "*")
+			if hasIDField(structs[typ]) {
+				providers[typ] = append(providers[typ], listProvider{service.Name, method})
+			}
+		}
+	}
+	return providers
+}
+
+// identifiedType returns the type identified by an ID param, or "" when the
+// param doesn't look like an ID:
+//   - addonID identifies an Addon
+//   - id identifies the type listed by its service, or returned by the method
+//   - deploy identifies the Deployment returned by the method
+func identifiedType(param Param, method Method, service Service, providers map[string][]listProvider) string {
+	name := strings.ToLower(param.Name)
+	returned := strings.TrimPrefix(strings.TrimPrefix(GetPrimaryReturnType(method), "[]"), "*")
+	_, listed := providers[returned]
+
+	switch {
+	case name == "id":
+		if typ := serviceListedType(service, providers); typ != "" {
+			return typ
+		}
+		if listed {
+			return returned
+		}
+	case strings.HasSuffix(param.Name, "ID") || strings.HasSuffix(param.Name, "Id"):
+		base := strings.TrimSuffix(name, "id")
+		for typ := range providers {
+			if strings.ToLower(typ) == base {
+				return typ
+			}
+		}
+	case listed && len(name) >= 3 && strings.HasPrefix(strings.ToLower(returned), name):
+		return returned
+	}
+	return ""
+}
+
+// serviceListedType returns the listed type the service is named after (e.g.,
+// Domain for DomainsService), "" if there is none
+func serviceListedType(service Service, providers map[string][]listProvider) string {
+	var listed string
+	for typ := range providers {
+		if strings.HasPrefix(service.Name, typ) && len(typ) > len(listed) {
+			listed = typ
+		}
+	}
+	return listed
+}
+
+// findProvider picks the list method of typ that can be called with the
+// params of method, preferring the same service, the fewest params, then
+// methods named *List
+func findProvider(typ string, method Method, serviceName string, providers []listProvider) *ResolvedParam {
+	var candidates []*ResolvedParam
+	for _, p := range providers {
+		if p.method.Name == method.Name {
+			continue
+		}
+		args, ok := providerArgs(p.method, method)
+		if !ok {
+			continue
+		}
+		candidates = append(candidates, &ResolvedParam{
+			Type:       typ,
+			Service:    p.service,
+			MethodName: p.method.Name,
+			Args:       args,
+		})
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if (a.Service == serviceName) != (b.Service == serviceName) {
+			return a.Service == serviceName
+		}
+		if len(a.Args) != len(b.Args) {
+			return len(a.Args) < len(b.Args)
+		}
+		if aList, bList := strings.HasSuffix(a.MethodName, "List"), strings.HasSuffix(b.MethodName, "List"); aList != bList {
+			return aList
+		}
+		return a.Service+"."+a.MethodName < b.Service+"."+b.MethodName
+	})
+	return candidates[0]
+}
+
+// providerArgs maps the params of a list method to the params of method
+// providing them: the same name, or both provided by the same root flag
+func providerArgs(provider, method Method) ([]string, bool) {
+	var args []string
+	for _, pp := range provider.Params {
+		found := ""
+		for _, mp := range method.Params {
+			if mp.Type != pp.Type {
+				continue
+			}
+			if mp.Name == pp.Name || sharedParams[mp.Name] != "" && sharedParams[mp.Name] == sharedParams[pp.Name] {
+				found = mp.Name
+				break
+			}
+		}
+		if found == "" {
+			return nil, false
+		}
+		args = append(args, found)
+	}
+	return args, true
+}
+
+// hasIDField reports whether the struct has a string ID field
+func hasIDField(ps ParsedStruct) bool {
+	for _, f := range ps.Fields {
+		if f.Name == "ID" && f.Type == "string" {
+			return true
+		}
+	}
+	return false
+}
+
+// resolvedNameFields are the string fields an item can be selected by, on top of its ID
+var resolvedNameFields = []string{"Name", "Username", "Email"}
+
+// nameFields returns the string fields of the struct an item can be selected by
+func nameFields(ps ParsedStruct) []string {
+	var fields []string
+	for _, name := range resolvedNameFields {
+		for _, f := range ps.Fields {
+			if f.Name == name && f.Type == "string" {
+				fields = append(fields, name)
+			}
+		}
+	}
+	return fields
+}
+
+// createdAtType returns the type of the CreatedAt field of the struct, if it's a time
+func createdAtType(ps ParsedStruct) string {
+	for _, f := range ps.Fields {
+		if f.Name == "CreatedAt" && (f.Type == "time.Time" || f.Type == "*time.Time") {
+			return f.Type
+		}
+	}
+	return ""
+}
+
+// destructiveWords are the words of the methods deleting the item they're
+// given, whose ID flag must then match an item exactly
+var destructiveWords = map[string]bool{"Destroy": true, "Delete": true, "Remove": true}
+
+// isDestructive reports whether the method deletes the item it's given
+// (e.g., AddonDestroy, KeysDelete)
+func isDestructive(method Method) bool {
+	for _, word := range names.Split(method.Name) {
+		if destructiveWords[word] {
+			return true
+		}
+	}
+	return false
+}
@@ -1,7 +1,7 @@
 package generator
 
 import (
-	"os"
+	"bytes"
 	"path/filepath"
 	"strings"
 
@@ -24,8 +24,19 @@ type CommandSpec struct {
 	Renderer string   `toml:"renderer"`
 }
 
-// GenerateSpec generates a TOML spec file for the new methods
-func GenerateSpec(newMethods map[string][]Method, outputPath string) (err error) {
+// GenerateSpec generates a TOML spec file for the new methods, rewriting it
+// only when its content changed
+func GenerateSpec(newMethods map[string][]Method, outputPath string) error {
+	content, err := RenderSpec(newMethods)
+	if err != nil {
+		return err
+	}
+	_, err = writeFileIfChanged(filepath.Join(outputPath, "spec.toml"), content)
+	return err
+}
+
+// RenderSpec returns the TOML spec of the new methods
+func RenderSpec(newMethods map[string][]Method) ([]byte, error) {
 	spec := Spec{
 		Version:  1,
 		Commands: make(map[string]CommandSpec),
@@ -35,14 +46,12 @@ func GenerateSpec(newMethods map[string][]Method, outputPath string) (err error)
 		prefix := strings.TrimSuffix(serviceName, "Service")
 
 		for _, method := range methods {
-			use := strings.TrimPrefix(method.Name, prefix)
-			use = toKebabCase(use)
-
-			commandKey := toKebabCase(prefix) + "-" + use
+			use := commandUse(serviceName, method.Name)
+			commandKey := names.Command(prefix) + "-" + use
 
 			var flags []string
 			for _, param := range method.Params {
-				flags = append(flags, toKebabCase(param.Name))
+				flags = append(flags, paramFlagName(param))
 			}
 
 			returnType := ""
@@ -70,17 +79,9 @@ func GenerateSpec(newMethods map[string][]Method, outputPath string) (err error)
 		}
 	}
 
-	specPath := filepath.Join(outputPath, "spec.toml")
-	f, err := os.Create(specPath)
-	if err != nil {
-		return err
+	var buf bytes.Buffer
+	if err := toml.NewEncoder(&buf).Encode(spec); err != nil {
+		return nil, err
 	}
-	defer func() {
-		if cerr := f.Close(); cerr != nil && err == nil {
-			err = cerr
-		}
-	}()
-
-	encoder := toml.NewEncoder(f)
-	return encoder.Encode(spec)
+	return buf.Bytes(), nil
 }
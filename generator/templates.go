@@ -0,0 +1,199 @@
+package generator
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// templateExt is the extension of template files in a templates directory
+const templateExt = ".tmpl"
+
+// defaultTemplates are the built-in templates by name. Each one can be
+// overridden by a <name>.tmpl file in a templates directory.
+var defaultTemplates = map[string]string{
+	"command":     commandTemplate,
+	"run":         runTemplate,
+	"flags":       flagsTemplate,
+	"register":    registerTemplate,
+	"client":      clientTemplate,
+	"hooks":       hooksTemplate,
+	"registry":    registryTemplate,
+	"resolve":     resolveTemplate,
+	"paginate":    paginateTemplate,
+	"stream":      streamTemplate,
+	"output":      outputTemplate,
+	"browse":      browseTemplate,
+	"fake_client": fakeClientTemplate,
+	"test":        serviceTestTemplate,
+}
+
+// TemplateDescriptions describes what each named template renders and the data
+// it is executed with
+var TemplateDescriptions = map[string]string{
+	"command":     "<service>.go, one file per service (ServiceFile)",
+	"run":         "body of a command's RunE function (CommandDef)",
+	"flags":       "body of a command's init function declaring its flags (CommandDef)",
+	"register":    "register.go, RegisterAll calling every service (ServiceFiles)",
+	"client":      "client.go, the Client interface and newClient (ServiceFiles)",
+	"hooks":       "hooks.go, the Hooks type and RegisterHooks (ServiceFiles)",
+	"registry":    "registry.go, ReplaceCommand and AttachCommand (ServiceFiles)",
+	"resolve":     "resolve.go, resolveID used by the ID selector flags (no data)",
+	"paginate":    "paginate.go, the pagination flags and page loop (no data)",
+	"stream":      "stream.go, the reconnect flag and loop of streaming commands (no data)",
+	"output":      "output.go, the manifest table columns, selectedFormat and renderOptions ([]ColumnPreset)",
+	"browse":      "browse.go, the row actions of --interactive and the table browser they run in (ServiceFiles)",
+	"fake_client": "client_test.go, the fake client shared by generated tests",
+	"test":        "<service>_test.go, one test per command (CommandTest)",
+}
+
+// Templates is the set of templates used to render generated code
+type Templates struct {
+	set        *template.Template
+	overridden []string
+}
+
+// DefaultTemplates returns the built-in templates
+func DefaultTemplates() *Templates {
+	t, err := parseTemplates(defaultTemplates)
+	if err != nil {
+		panic(err) // The built-in templates are covered by the golden tests
+	}
+	return t
+}
+
+// LoadTemplates returns the built-in templates, overridden by the <name>.tmpl
+// files found in dir. An empty dir returns the built-in templates.
+func LoadTemplates(dir string) (*Templates, error) {
+	if dir == "" {
+		return DefaultTemplates(), nil
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read templates directory: %w", err)
+	}
+
+	sources := make(map[string]string, len(defaultTemplates))
+	for name, src := range defaultTemplates {
+		sources[name] = src
+	}
+	var overridden []string
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != templateExt {
+			continue
+		}
+		name := strings.TrimSuffix(entry.Name(), templateExt)
+		if _, ok := defaultTemplates[name]; !ok {
+			return nil, fmt.Errorf("unknown template %s in %s (valid templates: %s)", entry.Name(), dir, strings.Join(TemplateNames(), ", "))
+		}
+		src, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read template: %w", err)
+		}
+		sources[name] = string(src)
+		overridden = append(overridden, name)
+	}
+
+	t, err := parseTemplates(sources)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(overridden)
+	t.overridden = overridden
+	return t, nil
+}
+
+// Overridden returns the names of the templates loaded from a templates directory
+func (t *Templates) Overridden() []string {
+	return t.overridden
+}
+
+// execute renders the named template with data, returning the unformatted output
+func (t *Templates) execute(name string, data any) ([]byte, error) {
+	return executeTemplate(t.set.Lookup(name), data)
+}
+
+// TemplateNames returns the names of the templates that can be overridden, in
+// sorted order
+func TemplateNames() []string {
+	templateNames := make([]string, 0, len(defaultTemplates))
+	for name := range defaultTemplates {
+		templateNames = append(templateNames, name)
+	}
+	sort.Strings(templateNames)
+	return templateNames
+}
+
+// DumpTemplates writes the built-in templates to dir as <name>.tmpl files, to
+// be used as a starting point for overrides. Existing files are only replaced
+// when overwrite is set. It returns the paths of the files written.
+func DumpTemplates(dir string, overwrite bool) ([]string, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create templates directory: %w", err)
+	}
+
+	var paths []string
+	for _, name := range TemplateNames() {
+		path := filepath.Join(dir, name+templateExt)
+		if _, err := os.Stat(path); err == nil && !overwrite {
+			return nil, fmt.Errorf("%s already exists (pass --force to overwrite it)", path)
+		}
+		paths = append(paths, path)
+	}
+
+	var written []string
+	for i, name := range TemplateNames() {
+		path := paths[i]
+		if err := os.WriteFile(path, []byte(defaultTemplates[name]), 0644); err != nil {
+			return written, fmt.Errorf("failed to write %s: %w", path, err)
+		}
+		written = append(written, path)
+	}
+	return written, nil
+}
+
+// parseTemplates parses the named template sources into a single set, so they
+// can invoke each other with {{template "name" .}}
+func parseTemplates(sources map[string]string) (*Templates, error) {
+	set := template.New("").Funcs(templateFuncs)
+	for _, name := range TemplateNames() {
+		if _, err := set.New(name).Parse(sources[name]); err != nil {
+			return nil, fmt.Errorf("failed to parse %s template: %w", name, err)
+		}
+	}
+	return &Templates{set: set}, nil
+}
+
+// templateFuncs are the functions available to templates, on top of the
+// text/template builtins
+var templateFuncs = template.FuncMap{
+	// Naming, following the generator's rules and the manifest acronyms
+	"pascal":  func(s string) string { return names.Pascal(s) },
+	"varName": func(s string) string { return names.Var(s) },
+	"flag":    func(s string) string { return names.Flag(s) },
+	"command": func(s string) string { return names.Command(s) },
+	"file":    func(s string) string { return names.File(s) },
+
+	// SDK types
+	"sdkType":   addScalingoPrefix,
+	"baseType":  func(typ string) string { return strings.TrimLeft(typ, "[]*") },
+	"isPointer": func(typ string) bool { return strings.HasPrefix(typ, "*") },
+	"isSlice":   func(typ string) bool { return strings.HasPrefix(typ, "[]") },
+	"isMap":     func(typ string) bool { return strings.HasPrefix(typ, "map[") },
+	"flagType":  flagGetterType,
+
+	// Strings
+	"lower":      strings.ToLower,
+	"upper":      strings.ToUpper,
+	"hasPrefix":  func(prefix, s string) bool { return strings.HasPrefix(s, prefix) },
+	"hasSuffix":  func(suffix, s string) bool { return strings.HasSuffix(s, suffix) },
+	"trimPrefix": func(prefix, s string) string { return strings.TrimPrefix(s, prefix) },
+	"trimSuffix": func(suffix, s string) string { return strings.TrimSuffix(s, suffix) },
+	"replace":    func(old, new, s string) string { return strings.ReplaceAll(s, old, new) },
+	"join":       func(sep string, elems []string) string { return strings.Join(elems, sep) },
+	"quote":      func(s string) string { return fmt.Sprintf("%q", s) },
+}
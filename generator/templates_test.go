@@ -0,0 +1,87 @@
+package generator
+
+import (
+	"bytes"
+	"errors"
+	"io/fs"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDumpTemplatesRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := DumpTemplates(dir, false); err != nil {
+		t.Fatalf("dump templates: %v", err)
+	}
+	if _, err := DumpTemplates(dir, false); err == nil {
+		t.Error("dump over existing templates succeeded without overwrite")
+	}
+
+	templates, err := LoadTemplates(dir)
+	if err != nil {
+		t.Fatalf("load templates: %v", err)
+	}
+	if got := templates.Overridden(); !reflect.DeepEqual(got, TemplateNames()) {
+		t.Errorf("overridden = %v, want %v", got, TemplateNames())
+	}
+
+	want := fixtureFiles(t)
+	got := fixtureFilesWith(t, templates)
+	for _, name := range want.Names() {
+		if !bytes.Equal(got[name], want[name]) {
+			t.Errorf("%s differs with the dumped templates:\n%s", name, unifiedDiff("default", "dumped", want[name], got[name]))
+		}
+	}
+}
+
+func TestTemplateOverride(t *testing.T) {
+	dir := t.TempDir()
+	override := `// Calls {{.Service}}.{{.Method.Name}} ({{.Method.Name | flag}}, {{.ReturnType | sdkType}})
+	{{template "default_flags" .}}`
+	writeTestFile(t, filepath.Join(dir, "flags.tmpl"), []byte(override))
+	writeTestFile(t, filepath.Join(dir, "notes.txt"), []byte("not a template"))
+
+	templates, err := LoadTemplates(dir)
+	if err != nil {
+		t.Fatalf("load templates: %v", err)
+	}
+	methods, structs := fixtureMethods(t)
+	if _, err := RenderCommands(methods, structs, templates); err == nil || !strings.Contains(err.Error(), "default_flags") {
+		t.Fatalf("expected an error for the undefined template, got %v", err)
+	}
+
+	writeTestFile(t, filepath.Join(dir, "flags.tmpl"), []byte(strings.Replace(override, `{{template "default_flags" .}}`, "", 1)))
+	if templates, err = LoadTemplates(dir); err != nil {
+		t.Fatalf("load templates: %v", err)
+	}
+	if got := templates.Overridden(); !reflect.DeepEqual(got, []string{"flags"}) {
+		t.Errorf("overridden = %v, want [flags]", got)
+	}
+
+	files := fixtureFilesWith(t, templates)
+	if want := "// Calls WidgetsService.WidgetShow (widget-show, *scalingo.Widget)"; !bytes.Contains(files["widgets.go"], []byte(want)) {
+		t.Errorf("widgets.go does not contain %q:\n%s", want, files["widgets.go"])
+	}
+	// Templates that aren't overridden are unchanged
+	defaults := fixtureFiles(t)
+	for _, name := range []string{"register.go", "client.go", "widgets_test.go"} {
+		if !bytes.Equal(files[name], defaults[name]) {
+			t.Errorf("%s changed by the flags override", name)
+		}
+	}
+}
+
+func TestLoadTemplatesUnknown(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "commands.tmpl"), []byte(""))
+
+	_, err := LoadTemplates(dir)
+	if err == nil || !strings.Contains(err.Error(), "unknown template commands.tmpl") || !strings.Contains(err.Error(), "command, ") {
+		t.Errorf("expected an unknown template error listing the valid names, got %v", err)
+	}
+	if _, err := LoadTemplates(filepath.Join(dir, "missing")); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("expected a not exist error, got %v", err)
+	}
+}
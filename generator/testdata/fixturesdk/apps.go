@@ -0,0 +1,8 @@
+package scalingo
+
+import "context"
+
+// AppsService names the app its methods act on "name", read from --app
+type AppsService interface {
+	AppsRename(ctx context.Context, name string, newName string) error
+}
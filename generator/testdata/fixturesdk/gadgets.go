@@ -0,0 +1,17 @@
+package scalingo
+
+import "context"
+
+type Gadget struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// GadgetsService lives in its own file so the parser reads several files
+type GadgetsService interface {
+	GadgetsList(ctx context.Context) ([]*Gadget, error)
+	GadgetShow(ctx context.Context, gadgetID string) (*Gadget, error)
+	// GadgetAttach resolves gadgetID from GadgetsList and widgetID from
+	// WidgetsList in another service
+	GadgetAttach(ctx context.Context, app, gadgetID, widgetID string) error
+}
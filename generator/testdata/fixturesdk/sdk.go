@@ -0,0 +1,113 @@
+// Package scalingo is a fixture SDK covering the shapes the generator parser
+// supports. It is only parsed, never compiled.
+package scalingo
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+type PaginationOpts struct {
+	Page    int
+	PerPage int
+}
+
+type PaginationMeta struct {
+	CurrentPage int
+	NextPage    int
+	TotalPages  int
+}
+
+// WidgetKind is a string type cast from the flag value
+type WidgetKind string
+
+type Widget struct {
+	ID        string            `json:"id"`
+	Name      string            `json:"name"`
+	Kind      WidgetKind        `json:"kind"`
+	Size      int               `json:"size,omitempty"`
+	CreatedAt time.Time         `json:"created_at"`
+	Labels    map[string]string `json:"labels"`
+}
+
+type Variable struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// Variables is decoded from a JSON flag value
+type Variables []*Variable
+
+type Part struct {
+	Name string `json:"name"`
+}
+
+// WidgetsCreateOpts is expanded into one flag per field
+type WidgetsCreateOpts struct {
+	Name        string            `json:"name"`
+	StackID     string            `json:"stack_id,omitempty"`
+	DeployURL   *string           `json:"deploy_url,omitempty"`
+	Size        int               `json:"size"`
+	Replicas    *int              `json:"replicas,omitempty"`
+	Quota       uint              `json:"quota"`
+	Enabled     bool              `json:"enabled"`
+	Ratio       float64           `json:"ratio"`
+	Tags        []string          `json:"tags"`
+	Labels      map[string]string `json:"labels"`
+	Parts       []Part            `json:"parts"`
+	ExpiresAt   time.Time         `json:"expires_at"`
+	Extra       interface{}       `json:"extra"`
+	internalRef string
+}
+
+// WidgetsExecParams has a field named App, provided by the root --app flag
+type WidgetsExecParams struct {
+	App     string   `json:"app"`
+	Command string   `json:"command"`
+	Env     []string `json:"env"`
+}
+
+// WidgetsSearchOptions is passed as an unnamed parameter
+type WidgetsSearchOptions struct {
+	Term  string `json:"term"`
+	Limit *int   `json:"limit,omitempty"`
+}
+
+// WidgetsService exercises every method shape
+type WidgetsService interface {
+	WidgetsList(ctx context.Context, app string) ([]*Widget, error)
+	WidgetsListAll(ctx context.Context, app string, opts PaginationOpts) ([]*Widget, PaginationMeta, error)
+	WidgetShow(ctx context.Context, app, widgetID string) (*Widget, error)
+	WidgetValue(ctx context.Context, app string, widgetID string) (Widget, error)
+	WidgetsCreate(ctx context.Context, app string, opts *WidgetsCreateOpts) (*Widget, error)
+	WidgetsExec(ctx context.Context, params WidgetsExecParams) (*Widget, error)
+	WidgetsUpdateKind(ctx context.Context, app string, widgetID string, kind WidgetKind) (*Widget, error)
+	WidgetsResize(ctx context.Context, app string, size int, replicas uint, force bool) error
+	WidgetsDelete(ctx context.Context, app string, widgetIDs []string) error
+	WidgetsSetVariables(ctx context.Context, app string, variables Variables) (Variables, int, error)
+	WidgetsRestart(ctx context.Context, app string) (widget *Widget, restarted bool, err error)
+	WidgetsToken(ctx context.Context, app string) (string, error)
+	WidgetsPing(ctx context.Context) error
+	WidgetsLogsURL(ctx context.Context, app string) (*http.Response, error)
+	WidgetsLogs(ctx context.Context, widgetsLogsURL string, n int) (*http.Response, error)
+	WidgetsStream(ctx context.Context, streamURL string) (*websocket.Conn, error)
+	WidgetsWatch(ctx context.Context, app string, callback func(*Widget)) error
+	WidgetsAny(ctx context.Context, app string, payload interface{}) error
+	WidgetsTypes(ctx context.Context) (map[string]string, error)
+	WidgetsSearch(context.Context, WidgetsSearchOptions) ([]*Widget, error)
+	Widgets(ctx context.Context, app string) ([]Widget, error)
+}
+
+// WidgetsPreviewService uses a different client and is skipped
+type WidgetsPreviewService interface {
+	WidgetsPreview(ctx context.Context, app string) error
+}
+
+// Client is not a service and is skipped
+type Client interface {
+	WidgetsService
+	WidgetsPreviewService
+}
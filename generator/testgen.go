@@ -0,0 +1,723 @@
+package generator
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// testPages is the number of pages returned by the fake client for paginated methods
+const testPages = 3
+
+// testURL prefixes the URLs returned by the fake client for chained calls
+const testURL = "https://test.invalid/"
+
+const fakeClientTemplate = `// Code generated by generative-cli. DO NOT EDIT.
+package commands
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	{{if .NeedsWebsocket}}"net/http/httptest"
+	{{end}}"reflect"
+	"strings"
+	"testing"
+
+	{{range .Imports}}{{.}}
+	{{end}}"github.com/spf13/cobra"
+
+	"generative-cli/config"
+	"generative-cli/render"
+)
+
+// testApp is the app passed to every command through the root --app flag
+const testApp = "test-app"
+
+// fakeCall records a single SDK call made through the fake client
+type fakeCall struct {
+	Method  string
+	Args    []any
+	Result  any   // First value returned
+	Results []any // Every non-error value returned, in order
+}
+
+// fakeClient is a Client returning canned values and recording every call.
+// Methods it doesn't implement panic through the nil embedded Client.
+type fakeClient struct {
+	Client
+	calls     []fakeCall
+	pages     int                 // Number of pages returned by paginated methods
+	responses map[string]string   // HTTP response bodies by method name
+	events    map[string][]string // Websocket messages by method name
+	cleanup   []func()            // Run at the end of the test
+}
+
+func (f *fakeClient) record(method string, args []any, results ...any) {
+	call := fakeCall{Method: method, Args: args, Results: results}
+	if len(results) > 0 {
+		call.Result = results[0]
+	}
+	f.calls = append(f.calls, call)
+}
+
+// renderedResults returns the results of call a command renders, names being
+// the result names of the rendered ones and "" for the others
+func renderedResults(call fakeCall, names ...string) any {
+	var results render.Results
+	for i, name := range names {
+		if name != "" {
+			results = append(results, render.NamedResult{Name: name, Value: call.Results[i]})
+		}
+	}
+	if len(results) == 1 {
+		return results[0].Value
+	}
+	return results
+}
+
+// response returns the HTTP response configured for method
+func (f *fakeClient) response(method string) *http.Response {
+	body, ok := f.responses[method]
+	if !ok {
+		body = method + " response\n"
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
+
+{{if .NeedsWebsocket}}
+// dialEvents connects to a local websocket server sending the messages
+// configured for method, then closing the connection normally
+func (f *fakeClient) dialEvents(method string) *websocket.Conn {
+	events, ok := f.events[method]
+	if !ok {
+		events = []string{method + " event"}
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for _, event := range events {
+			if err := conn.WriteMessage(websocket.TextMessage, []byte(event)); err != nil {
+				return
+			}
+		}
+		conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+	}))
+	f.cleanup = append(f.cleanup, server.Close)
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	if err != nil {
+		panic(err)
+	}
+	return conn
+}
+{{end}}
+// callsTo returns the recorded calls to method
+func (f *fakeClient) callsTo(method string) []fakeCall {
+	var calls []fakeCall
+	for _, call := range f.calls {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// expectCall checks that method was called once with the given arguments
+func (f *fakeClient) expectCall(t *testing.T, method string, args []any) fakeCall {
+	t.Helper()
+	calls := f.callsTo(method)
+	if len(calls) != 1 {
+		t.Fatalf("%s called %d times, want 1 (calls: %v)", method, len(calls), f.calls)
+	}
+	assertArgs(t, calls[0], args)
+	return calls[0]
+}
+
+// assertArgs checks the arguments of a recorded call
+func assertArgs(t *testing.T, call fakeCall, want []any) {
+	t.Helper()
+	if !reflect.DeepEqual(call.Args, want) {
+		t.Errorf("%s called with %#v, want %#v", call.Method, call.Args, want)
+	}
+}
+
+// useFakeClient makes the commands use a fake client for the duration of the test
+func useFakeClient(t *testing.T) *fakeClient {
+	t.Helper()
+	fake := &fakeClient{pages: 1, responses: make(map[string]string), events: make(map[string][]string)}
+
+	prevClient, prevApp, prevOutput := newClient, config.C.AppFlag, config.C.OutputFlag
+	prevColumns, prevWide, prevDepth := config.C.ColumnsFlag, config.C.WideFlag, config.C.DepthFlag
+	prevQuery, prevTemplateFile := config.C.QueryFlag, config.C.TemplateFileFlag
+	newClient = func(ctx context.Context) (Client, error) {
+		return fake, nil
+	}
+	config.C.AppFlag = testApp
+	config.C.OutputFlag = string(render.FormatTable)
+	config.C.ColumnsFlag, config.C.WideFlag, config.C.DepthFlag = nil, false, render.Rules.MaxDepth
+	config.C.QueryFlag, config.C.TemplateFileFlag = "", ""
+	t.Cleanup(func() {
+		newClient, config.C.AppFlag, config.C.OutputFlag = prevClient, prevApp, prevOutput
+		config.C.ColumnsFlag, config.C.WideFlag, config.C.DepthFlag = prevColumns, prevWide, prevDepth
+		config.C.QueryFlag, config.C.TemplateFileFlag = prevQuery, prevTemplateFile
+		for _, cleanup := range fake.cleanup {
+			cleanup()
+		}
+	})
+	return fake
+}
+
+var testRoot *cobra.Command
+
+// runCommand runs the command tree with args and returns what was written to stdout
+func runCommand(t *testing.T, args ...string) string {
+	t.Helper()
+	if testRoot == nil {
+		testRoot = &cobra.Command{Use: "test", SilenceUsage: true, SilenceErrors: true}
+		RegisterAll(testRoot)
+	}
+
+	var out bytes.Buffer
+	testRoot.SetOut(&out)
+	testRoot.SetArgs(args)
+	if err := testRoot.ExecuteContext(context.Background()); err != nil {
+		t.Fatalf("%v: %v", args, err)
+	}
+	return out.String()
+}
+
+// assertRendered checks that out is result rendered with the expected renderer
+func assertRendered(t *testing.T, out string, result any, renderer string) {
+	t.Helper()
+	kind := reflect.TypeOf(result).Kind()
+	if renderer == "table" && kind != reflect.Slice {
+		t.Errorf("table renderer selected for a %s result", kind)
+	}
+	if renderer == "detail" && kind == reflect.Slice && reflect.TypeOf(result).Name() == "" {
+		// Named slice types (e.g. scalingo.Events) are inferred as detail
+		t.Errorf("detail renderer selected for a slice result")
+	}
+
+	want, err := render.RenderResult(result, render.FormatTable)
+	if err != nil {
+		t.Fatalf("render result: %v", err)
+	}
+	assertOutput(t, out, want+"\n")
+}
+
+// assertPages checks that out is the pages returned by calls, streamed as a
+// table by a paginated command
+func assertPages(t *testing.T, out string, calls []fakeCall) {
+	t.Helper()
+	var want bytes.Buffer
+	pages := render.NewPageWriter(&want, render.FormatTable, render.Options{})
+	for _, call := range calls {
+		if err := pages.Write(call.Result); err != nil {
+			t.Fatalf("render page: %v", err)
+		}
+	}
+	if err := pages.Close(nil, len(calls)); err != nil {
+		t.Fatalf("render pages: %v", err)
+	}
+	assertOutput(t, out, want.String())
+}
+
+// assertSuccess checks that out is the success message of the use command
+func assertSuccess(t *testing.T, out, use string) {
+	t.Helper()
+	assertOutput(t, out, render.RenderSuccess(use+" completed successfully")+"\n")
+}
+
+func assertOutput(t *testing.T, got, want string) {
+	t.Helper()
+	if got != want {
+		t.Errorf("output mismatch\ngot:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// decodeJSON decodes a JSON flag value the way the commands do
+func decodeJSON[T any](t *testing.T, data string) T {
+	t.Helper()
+	var v T
+	if err := json.Unmarshal([]byte(data), &v); err != nil {
+		t.Fatalf("decode %s: %v", data, err)
+	}
+	return v
+}
+{{range .Fakes}}
+func (f *fakeClient) {{.Name}}({{.Params}}) ({{range .Returns}}{{.Type}}, {{end}}error) {
+	{{range $i, $r := .Returns}}r{{$i}} := {{$r.Value}}
+	{{end}}{{if and (ge .PageParam 0) (ge .MetaReturn 0)}}if p{{.PageParam}}.Page < f.pages {
+		r{{.MetaReturn}}.NextPage = p{{.PageParam}}.Page + 1
+	}
+	{{end}}f.record("{{.Name}}", []any{ {{- .Args -}} }{{range $i, $r := .Returns}}, r{{$i}}{{end}})
+	return {{range $i, $r := .Returns}}r{{$i}}, {{end}}nil
+}
+{{end}}`
+
+const serviceTestTemplate = `// Code generated by generative-cli. DO NOT EDIT.
+package commands
+
+import (
+	"testing"
+	{{if .NeedsSDK}}
+	scalingo "github.com/Scalingo/go-scalingo/v8"{{end}}
+)
+{{range $test := .Tests}}
+func Test{{$test.Name}}(t *testing.T) {
+	fake := useFakeClient(t)
+	{{if $test.Paginated}}fake.pages = {{$test.Pages}}
+	{{end}}{{range $test.Calls}}{{if .Response}}fake.responses["{{.MethodName}}"] = {{printf "%q" .Response}}
+	{{end}}{{end}}
+	out := runCommand(t, {{$test.Args}})
+
+	{{range $test.Calls}}fake.expectCall(t, "{{.MethodName}}", []any{ {{- .Args -}} })
+	{{end}}{{if $test.Paginated}}calls := fake.callsTo("{{$test.MethodName}}")
+	if len(calls) != {{$test.Pages}} {
+		t.Fatalf("{{$test.MethodName}} called %d times, want {{$test.Pages}}", len(calls))
+	}
+	for i, call := range calls {
+		assertArgs(t, call, []any{ {{- $test.CallArgs -}} })
+	}
+	assertPages(t, out, calls)
+	{{- else if eq $test.Renderer "success"}}fake.expectCall(t, "{{$test.MethodName}}", []any{ {{- $test.CallArgs -}} })
+	assertSuccess(t, out, "{{$test.Use}}")
+	{{- else if $test.Output}}fake.expectCall(t, "{{$test.MethodName}}", []any{ {{- $test.CallArgs -}} })
+	assertOutput(t, out, {{printf "%q" $test.Output}})
+	{{- else}}call := fake.expectCall(t, "{{$test.MethodName}}", []any{ {{- $test.CallArgs -}} })
+	assertRendered(t, out, {{if $test.Results}}renderedResults(call, {{$test.Results}}){{else}}call.Result{{end}}, "{{$test.Renderer}}")
+	{{- end}}
+}
+{{end}}`
+
+// CommandTest describes the test generated for a command: the CLI arguments
+// it runs with and the SDK calls the fake client should receive.
+type CommandTest struct {
+	Name       string         // Test function suffix (the command var name)
+	Use        string         // Command name, used to check the success message
+	Args       string         // Go literals of the CLI arguments
+	MethodName string         // Main SDK method
+	CallArgs   string         // Expected main call arguments, without the context
+	Calls      []ExpectedCall // Expected chained calls, in order
+	Renderer   string         // Renderer the command should select
+	Output     string         // Output of commands printing the HTTP response or websocket messages
+	Results    string         // Go literals of the result names of methods returning several values, "" for the ones not rendered
+	Paginated  bool           // Whether the command loops over pages
+	Pages      int            // Number of pages returned by the fake client
+	Skip       bool           // True when the method can't be faked
+}
+
+// ExpectedCall is a chained SDK call the generated command should make
+type ExpectedCall struct {
+	MethodName string
+	Args       string // Expected arguments, without the context
+	Response   string // HTTP response body returned by the fake client
+}
+
+// FakeMethod is a fake client method recording its calls
+type FakeMethod struct {
+	Name       string
+	Params     string // Parameter list, including the context
+	Args       string // Recorded arguments
+	Returns    []FakeReturn
+	PageParam  int // Index of the PaginationOpts param, -1 if none
+	MetaReturn int // Index of the PaginationMeta return, -1 if none
+}
+
+// FakeReturn is a value returned by a fake client method
+type FakeReturn struct {
+	Type  string
+	Value string
+}
+
+// serviceTestFile is the data of a generated <service>_test.go file
+type serviceTestFile struct {
+	NeedsSDK bool
+	Tests    []CommandTest
+}
+
+// typePackages maps the package qualifiers of SDK types to their import
+// declaration in generated test files
+var typePackages = map[string]string{
+	"scalingo":  `scalingo "github.com/Scalingo/go-scalingo/v8"`,
+	"http":      `"net/http"`,
+	"time":      `"time"`,
+	"json":      `"encoding/json"`,
+	"url":       `"net/url"`,
+	"io":        `"io"`,
+	"websocket": `"github.com/gorilla/websocket"`,
+}
+
+var qualifierRe = regexp.MustCompile(`\b([a-z][A-Za-z0-9]*)\.`)
+
+// buildCommandTest derives the test of a generated command from the method it calls
+func buildCommandTest(serviceUse string, cmd CommandDef, method Method, newMethods map[string][]Method) CommandTest {
+	test := CommandTest{
+		Name:       names.Pascal(cmd.VarName),
+		Use:        cmd.Use,
+		MethodName: method.Name,
+		Renderer:   cmd.RendererType,
+		Paginated:  cmd.AutoPaginate,
+		Pages:      1,
+	}
+	if cmd.AutoPaginate {
+		test.Pages = testPages
+	}
+	switch {
+	case cmd.ReturnType == "*websocket.Conn":
+		test.Output = method.Name + " event\n"
+	case cmd.ReturnType == "*http.Response":
+		test.Output = method.Name + " response\n"
+	}
+	if method.Custom || !fakeable(method) {
+		test.Skip = true
+		return test
+	}
+
+	// Every flag gets a value so the test checks it reaches the SDK call
+	flagVars := make(map[string]FlagVar)
+	cliArgs := []string{strconv.Quote(serviceUse), strconv.Quote(cmd.Use)}
+	for _, fv := range cmd.FlagVars {
+		flagVars[fv.Name] = fv
+	}
+	for _, flag := range cmd.Flags {
+		// Selector flags can't be set along with the ID flag they resolve
+		if flag.Resolves != "" {
+			continue
+		}
+		fv := flagVarForFlag(cmd, flag.Name)
+		value := testFlagValue(flag.Type, fv)
+		cliArgs = append(cliArgs, strconv.Quote("--"+flag.Name+"="+value))
+	}
+
+	builders := make(map[string]StructBuilder)
+	for _, b := range cmd.StructBuilders {
+		builders[b.VarName] = b
+	}
+
+	var callArgs []string
+	for _, param := range method.Params {
+		varName := names.Var(param.Name)
+		switch {
+		case IsPaginationParam(param.Type):
+			page := "1"
+			if cmd.AutoPaginate {
+				page = "i + 1"
+			}
+			lit := fmt.Sprintf("scalingo.PaginationOpts{Page: %s, PerPage: 100}", page)
+			if strings.HasPrefix(param.Type, "*") {
+				lit = "&" + lit
+			}
+			callArgs = append(callArgs, lit)
+		case param.ChainedFrom != nil:
+			source := findMethod(newMethods, param.ChainedFrom.MethodName)
+			if source == nil || !fakeable(*source) {
+				test.Skip = true
+				return test
+			}
+			var sourceArgs []string
+			for _, srcParam := range param.ChainedFrom.SourceParams {
+				sourceArgs = append(sourceArgs, testLiteral(flagVars[names.Var(srcParam.Name)], srcParam.Type))
+			}
+			url := testURL + varName
+			test.Calls = append(test.Calls, ExpectedCall{
+				MethodName: param.ChainedFrom.MethodName,
+				Args:       strings.Join(sourceArgs, ", "),
+				Response:   fmt.Sprintf(`{"logs_url": %q}`, url),
+			})
+			callArgs = append(callArgs, strconv.Quote(url))
+		default:
+			if b, ok := builders[varName]; ok {
+				callArgs = append(callArgs, structLiteral(b, flagVars))
+				continue
+			}
+			callArgs = append(callArgs, testLiteral(flagVars[varName], param.Type))
+		}
+	}
+
+	if cmd.AutoPaginate {
+		cliArgs = append(cliArgs, strconv.Quote("--all"))
+	} else if cmd.HasExtraReturn {
+		resultNames := make([]string, len(cmd.Results))
+		for i, r := range cmd.Results {
+			if r.Var != "_" {
+				resultNames[i] = strconv.Quote(r.Name)
+			} else {
+				resultNames[i] = `""`
+			}
+		}
+		test.Results = strings.Join(resultNames, ", ")
+	}
+	test.Args = strings.Join(cliArgs, ", ")
+	test.CallArgs = strings.Join(callArgs, ", ")
+	return test
+}
+
+// flagVarForFlag returns the flag variable reading the named flag
+func flagVarForFlag(cmd CommandDef, flagName string) FlagVar {
+	for _, fv := range cmd.FlagVars {
+		if fv.FlagName == flagName && fv.ConfigGetter == "" {
+			return fv
+		}
+	}
+	return FlagVar{FlagName: flagName}
+}
+
+// testFlagValue returns the CLI value set for a flag in generated tests
+func testFlagValue(flagType string, fv FlagVar) string {
+	if fv.NeedsJSON {
+		return "[]"
+	}
+	switch flagType {
+	case "Int", "Uint":
+		return "42"
+	case "Bool":
+		return "true"
+	case "Float64":
+		return "1.5"
+	case "StringSlice":
+		return "a,b"
+	default:
+		return "test-" + fv.FlagName
+	}
+}
+
+// testLiteral returns the Go literal a command should pass to the SDK for a
+// flag set with testFlagValue
+func testLiteral(fv FlagVar, goType string) string {
+	if fv.ConfigGetter != "" {
+		return "testApp"
+	}
+	if fv.NeedsJSON {
+		return fmt.Sprintf("decodeJSON[%s](t, %q)", fv.JSONType, "[]")
+	}
+
+	baseType := strings.TrimPrefix(goType, "*")
+	var lit string
+	switch fv.GetterType {
+	case "Int", "Uint":
+		lit = baseType + "(42)"
+	case "Float64":
+		lit = baseType + "(1.5)"
+	case "Bool":
+		lit = "true"
+		if baseType != "bool" {
+			lit = addScalingoPrefix(baseType) + "(true)"
+		}
+	case "StringSlice":
+		lit = `[]string{"a", "b"}`
+	default:
+		lit = strconv.Quote("test-" + fv.FlagName)
+		if fv.TypeCast != "" {
+			lit = fv.TypeCast + "(" + lit + ")"
+		} else if baseType != "string" {
+			lit = addScalingoPrefix(baseType) + "(" + lit + ")"
+		}
+	}
+	if strings.HasPrefix(goType, "*") {
+		lit = "ptr(" + lit + ")"
+	}
+	return lit
+}
+
+// structLiteral returns the struct a builder should produce from the test flags
+func structLiteral(b StructBuilder, flagVars map[string]FlagVar) string {
+	var fields []string
+	for _, f := range b.Fields {
+		if f.Skip {
+			continue
+		}
+		fields = append(fields, f.FieldName+": "+testLiteral(flagVars[f.FlagVar], f.FieldType))
+	}
+	lit := "scalingo." + b.TypeName + "{" + strings.Join(fields, ", ") + "}"
+	if b.IsPointer {
+		lit = "&" + lit
+	}
+	return lit
+}
+
+// findMethod looks up a method by name across all services
+func findMethod(newMethods map[string][]Method, name string) *Method {
+	for _, serviceName := range sortedServiceNames(newMethods) {
+		methods := newMethods[serviceName]
+		for i := range methods {
+			if methods[i].Name == name {
+				return &methods[i]
+			}
+		}
+	}
+	return nil
+}
+
+// fakeable reports whether the fake client can implement method, i.e. all its
+// types were parsed and come from packages the test file can import
+func fakeable(method Method) bool {
+	if !method.HasContext {
+		return false
+	}
+	var types []string
+	for _, p := range method.Params {
+		types = append(types, p.Type)
+	}
+	for _, r := range method.Returns {
+		types = append(types, r.Type)
+	}
+	for _, t := range types {
+		if strings.Contains(t, "unknown") {
+			return false
+		}
+		for _, m := range qualifierRe.FindAllStringSubmatch(addScalingoPrefix(t), -1) {
+			if _, ok := typePackages[m[1]]; !ok {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// fakeMethod builds the fake client implementation of method
+func fakeMethod(method Method, structs map[string]ParsedStruct) FakeMethod {
+	fm := FakeMethod{Name: method.Name, PageParam: -1, MetaReturn: -1}
+
+	params := []string{"ctx context.Context"}
+	var args []string
+	for i, p := range method.Params {
+		params = append(params, fmt.Sprintf("p%d %s", i, addScalingoPrefix(p.Type)))
+		args = append(args, fmt.Sprintf("p%d", i))
+		if p.Type == "PaginationOpts" {
+			fm.PageParam = i
+		}
+	}
+	fm.Params = strings.Join(params, ", ")
+	fm.Args = strings.Join(args, ", ")
+
+	for _, r := range method.Returns {
+		if r.IsError {
+			continue
+		}
+		if r.Type == "PaginationMeta" {
+			fm.MetaReturn = len(fm.Returns)
+		}
+		fm.Returns = append(fm.Returns, FakeReturn{
+			Type:  addScalingoPrefix(r.Type),
+			Value: fakeValue(method.Name, r.Type, structs),
+		})
+	}
+	return fm
+}
+
+// fakeValue returns the expression a fake method returns for a type. SDK
+// structs are returned non-nil so the renderers have something to show.
+func fakeValue(methodName, typ string, structs map[string]ParsedStruct) string {
+	switch typ {
+	case "*http.Response":
+		return fmt.Sprintf("f.response(%q)", methodName)
+	case "*websocket.Conn":
+		return fmt.Sprintf("f.dialEvents(%q)", methodName)
+	}
+	base := strings.TrimPrefix(strings.TrimPrefix(strings.TrimPrefix(typ, "[]"), "*"), "*")
+	if _, ok := structs[base]; ok {
+		switch typ {
+		case base:
+			return "scalingo." + base + "{}"
+		case "*" + base:
+			return "&scalingo." + base + "{}"
+		case "[]" + base, "[]*" + base:
+			return addScalingoPrefix(typ) + "{{}}"
+		}
+	}
+	return "*new(" + addScalingoPrefix(typ) + ")"
+}
+
+// renderTests adds a test file per service to files, along with the fake client they share
+func renderTests(files GeneratedFiles, templates *Templates, serviceFiles []ServiceFile, newMethods map[string][]Method, structs map[string]ParsedStruct) error {
+	// Fake every method, including the ones only used by chained calls and resolvers
+	var fakes []FakeMethod
+	imports := make(map[string]bool)
+	seen := make(map[string]bool)
+	for _, serviceName := range sortedServiceNames(newMethods) {
+		for _, method := range newMethods[serviceName] {
+			if seen[method.Name] || !fakeable(method) {
+				continue
+			}
+			seen[method.Name] = true
+			fm := fakeMethod(method, structs)
+			fakes = append(fakes, fm)
+			for _, m := range qualifierRe.FindAllStringSubmatch(fm.Params+" "+fakeReturnTypes(fm), -1) {
+				imports[typePackages[m[1]]] = true
+			}
+		}
+	}
+	sort.Slice(fakes, func(i, j int) bool { return fakes[i].Name < fakes[j].Name })
+
+	// Imports already declared by the template
+	delete(imports, typePackages["http"])
+	delete(imports, typePackages["json"])
+	delete(imports, typePackages["io"])
+	var importList []string
+	for imp := range imports {
+		importList = append(importList, imp)
+	}
+	sort.Strings(importList)
+
+	data := struct {
+		Imports        []string
+		Fakes          []FakeMethod
+		NeedsWebsocket bool // Whether a fake returns a websocket, see dialEvents
+	}{importList, fakes, imports[typePackages["websocket"]]}
+	var err error
+	if files["client_test.go"], err = templates.execute("fake_client", data); err != nil {
+		return fmt.Errorf("failed to generate client_test.go: %w", err)
+	}
+
+	for _, sf := range serviceFiles {
+		var tf serviceTestFile
+		for _, cmd := range sf.Commands {
+			if cmd.Test.Skip {
+				continue
+			}
+			tf.Tests = append(tf.Tests, cmd.Test)
+			if strings.Contains(cmd.Test.CallArgs, "scalingo.") {
+				tf.NeedsSDK = true
+			}
+			for _, call := range cmd.Test.Calls {
+				if strings.Contains(call.Args, "scalingo.") {
+					tf.NeedsSDK = true
+				}
+			}
+		}
+		if len(tf.Tests) == 0 {
+			continue
+		}
+
+		filename := names.File(strings.TrimSuffix(sf.ServiceName, "Service")) + "_test.go"
+		if files[filename], err = templates.execute("test", tf); err != nil {
+			return fmt.Errorf("failed to generate %s: %w", filename, err)
+		}
+	}
+
+	return nil
+}
+
+func fakeReturnTypes(fm FakeMethod) string {
+	var types []string
+	for _, r := range fm.Returns {
+		types = append(types, r.Type)
+	}
+	return strings.Join(types, " ")
+}
@@ -0,0 +1,249 @@
+package generator
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// CodeError is a problem found in generated code, traced back to the manifest
+// method the offending declaration was generated from
+type CodeError struct {
+	File   string
+	Line   int
+	Column int
+	Method string // "Service.Method", empty for code shared by all methods
+	Msg    string
+}
+
+func (e CodeError) Error() string {
+	pos := fmt.Sprintf("%s:%d:%d", e.File, e.Line, e.Column)
+	if e.Method == "" {
+		return fmt.Sprintf("%s: %s", pos, e.Msg)
+	}
+	return fmt.Sprintf("%s (manifest method %s): %s", pos, e.Method, e.Msg)
+}
+
+// CodeErrors lists the problems found in generated code
+type CodeErrors []CodeError
+
+func (e CodeErrors) Error() string {
+	sorted := append(CodeErrors(nil), e...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].File != sorted[j].File {
+			return sorted[i].File < sorted[j].File
+		}
+		return sorted[i].Line < sorted[j].Line
+	})
+
+	lines := make([]string, len(sorted))
+	for i, err := range sorted {
+		lines[i] = err.Error()
+	}
+	return fmt.Sprintf("generated code has %d error(s):\n  %s", len(e), strings.Join(lines, "\n  "))
+}
+
+// Methods returns the manifest methods involved in the errors, in sorted order
+func (e CodeErrors) Methods() []string {
+	seen := make(map[string]bool)
+	var methods []string
+	for _, err := range e {
+		if err.Method != "" && !seen[err.Method] {
+			seen[err.Method] = true
+			methods = append(methods, err.Method)
+		}
+	}
+	sort.Strings(methods)
+	return methods
+}
+
+// TypeCheck type-checks the generated Go files as a single package, tests
+// included, along with the hand-written files of outputPath (hooks, custom
+// commands and their tests) which use the generated code. Imports (the SDK,
+// cobra, the config and render packages) are resolved from source relative to
+// outputPath, so it must be run from within the module.
+func TypeCheck(outputPath string, files GeneratedFiles, newMethods map[string][]Method) error {
+	origins := declOrigins(newMethods)
+	handWritten, err := handWrittenFiles(outputPath, files)
+	if err != nil {
+		return err
+	}
+
+	// Import lookups run from the file's directory, which may not exist yet
+	dir := outputPath
+	if _, err := os.Stat(dir); err != nil {
+		dir = "."
+	}
+
+	fset := token.NewFileSet()
+	var errs CodeErrors
+	var importErrs []string
+	var parsed []*ast.File
+	for _, name := range files.Names() {
+		if filepath.Ext(name) != ".go" {
+			continue
+		}
+		f, err := parser.ParseFile(fset, filepath.Join(dir, name), files[name], parser.SkipObjectResolution)
+		if err != nil {
+			errs = append(errs, syntaxErrors(name, files[name], err, origins)...)
+			continue
+		}
+		parsed = append(parsed, f)
+	}
+	for _, name := range handWritten.Names() {
+		f, err := parser.ParseFile(fset, filepath.Join(dir, name), handWritten[name], parser.SkipObjectResolution)
+		if err != nil {
+			errs = append(errs, syntaxErrors(name, handWritten[name], err, nil)...)
+			continue
+		}
+		// External tests (package commands_test) are another package
+		if f.Name.Name == "commands" {
+			parsed = append(parsed, f)
+		}
+	}
+
+	conf := types.Config{
+		Importer: importer.ForCompiler(fset, "source", nil),
+		Error: func(err error) {
+			var terr types.Error
+			if !errors.As(err, &terr) {
+				errs = append(errs, CodeError{Msg: err.Error()})
+				return
+			}
+			// Unresolved imports break every use of the package, whatever the manifest says
+			if strings.HasPrefix(terr.Msg, "could not import ") {
+				if !slices.Contains(importErrs, terr.Msg) {
+					importErrs = append(importErrs, terr.Msg)
+				}
+				return
+			}
+			pos := terr.Fset.Position(terr.Pos)
+			name := filepath.Base(pos.Filename)
+			if src, ok := handWritten[name]; ok {
+				// Hand-written code isn't generated from a manifest method
+				errs = append(errs, codeError(name, src, pos.Line, pos.Column, terr.Msg, nil))
+				return
+			}
+			errs = append(errs, codeError(name, files[name], pos.Line, pos.Column, terr.Msg, origins))
+		},
+	}
+	// Errors are collected by conf.Error
+	_, _ = conf.Check("commands", fset, parsed, nil)
+
+	if len(importErrs) > 0 {
+		return fmt.Errorf("%s (generate must run from the module root with the SDK available)", strings.Join(importErrs, "; "))
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// handWrittenFiles reads the Go files of outputPath that the generator doesn't
+// write, the ones without the generated header. Stale generated files are
+// left out as writing the output removes them.
+func handWrittenFiles(outputPath string, files GeneratedFiles) (GeneratedFiles, error) {
+	entries, err := os.ReadDir(outputPath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read output directory: %w", err)
+	}
+
+	handWritten := make(GeneratedFiles)
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") {
+			continue
+		}
+		if _, ok := files[name]; ok {
+			continue
+		}
+		content, err := os.ReadFile(filepath.Join(outputPath, name))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", name, err)
+		}
+		if !bytes.HasPrefix(content, []byte(generatedHeader)) {
+			handWritten[name] = content
+		}
+	}
+	return handWritten, nil
+}
+
+// syntaxErrors converts a parse error of a generated file into code errors
+func syntaxErrors(name string, src []byte, err error, origins map[string]string) CodeErrors {
+	var list scanner.ErrorList
+	if !errors.As(err, &list) {
+		return CodeErrors{{File: name, Msg: err.Error()}}
+	}
+	var errs CodeErrors
+	for _, e := range list {
+		errs = append(errs, codeError(name, src, e.Pos.Line, e.Pos.Column, e.Msg, origins))
+	}
+	return errs
+}
+
+// codeError builds the error at line:column of a generated file, looking up
+// the manifest method of the enclosing declaration
+func codeError(name string, src []byte, line, column int, msg string, origins map[string]string) CodeError {
+	return CodeError{
+		File:   name,
+		Line:   line,
+		Column: column,
+		Method: origins[enclosingDecl(src, line)],
+		Msg:    msg,
+	}
+}
+
+// declOrigins maps the top-level declarations generated for each method (its
+// command var, init func, test and fake client method) to "Service.Method"
+func declOrigins(newMethods map[string][]Method) map[string]string {
+	origins := make(map[string]string)
+	for _, serviceName := range sortedServiceNames(newMethods) {
+		for _, method := range newMethods[serviceName] {
+			origin := serviceName + "." + method.Name
+			origins["fakeClient."+method.Name] = origin
+			varName := commandVarName(serviceName, method.Name)
+			origins[varName] = origin
+			origins["init"+varName] = origin
+			origins["Test"+names.Pascal(varName)] = origin
+		}
+	}
+	return origins
+}
+
+var declRe = regexp.MustCompile(`^(?:var (\w+)|func \(\w+ \*?(\w+)\) (\w+)|func (\w+))`)
+
+// enclosingDecl returns the name of the top-level declaration containing the
+// given line, as "Recv.Method" for methods. Generated code is gofmt-style, so
+// top-level declarations start at the beginning of a line.
+func enclosingDecl(src []byte, line int) string {
+	lines := strings.Split(string(src), "\n")
+	for i := min(line, len(lines)) - 1; i >= 0; i-- {
+		m := declRe.FindStringSubmatch(lines[i])
+		switch {
+		case m == nil:
+			continue
+		case m[1] != "":
+			return m[1]
+		case m[2] != "":
+			return m[2] + "." + m[3]
+		default:
+			return m[4]
+		}
+	}
+	return ""
+}
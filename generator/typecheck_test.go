@@ -0,0 +1,94 @@
+package generator
+
+import (
+	"bytes"
+	"errors"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestFormatFilesReportsMethod(t *testing.T) {
+	services, _ := parseFixture(t)
+	methods := make(map[string][]Method)
+	for _, svc := range services {
+		methods[svc.Name] = svc.Methods
+	}
+
+	files := fixtureFiles(t)
+	broken := bytes.Replace(files["widgets.go"], []byte("client.WidgetsCreate("), []byte("client.WidgetsCreate(("), 1)
+	files["widgets.go"] = broken
+
+	errs := formatFiles(files, declOrigins(methods))
+	if len(errs) == 0 {
+		t.Fatal("expected a syntax error")
+	}
+	if got, want := errs.Methods(), []string{"WidgetsService.WidgetsCreate"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("methods = %v, want %v", got, want)
+	}
+	if !strings.Contains(errs.Error(), "widgets.go:") {
+		t.Errorf("error does not name the file: %v", errs)
+	}
+	if !bytes.Equal(files["widgets.go"], broken) {
+		t.Error("broken file was modified")
+	}
+}
+
+// typeCheckMethods are the methods of the hand-built files of the type check tests
+var typeCheckMethods = map[string][]Method{"WidgetsService": {{Name: "WidgetsShow"}, {Name: "WidgetsList"}}}
+
+// typeCheckFiles are generated files without imports, whose widgetsShowCmd
+// uses widgetLabel and whose widgetsListCmd assigns a string to an int
+func typeCheckFiles() GeneratedFiles {
+	return GeneratedFiles{"widgets.go": []byte(generatedHeader + `
+package commands
+
+var widgetsShowCmd = widgetLabel()
+
+var widgetsListCmd int = "list"
+`)}
+}
+
+func TestTypeCheckReportsMethod(t *testing.T) {
+	outputPath := t.TempDir()
+	writeTestFile(t, filepath.Join(outputPath, "hooks_widgets.go"), []byte("package commands\n\nfunc widgetLabel() string { return \"widget\" }\n"))
+
+	err := TypeCheck(outputPath, typeCheckFiles(), typeCheckMethods)
+	var errs CodeErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("expected code errors, got %v", err)
+	}
+	if got, want := errs.Methods(), []string{"WidgetsService.WidgetsList"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("methods = %v, want %v (errors: %v)", got, want, errs)
+	}
+	if !strings.Contains(errs.Error(), "widgets.go:6:") {
+		t.Errorf("error does not name the line: %v", errs)
+	}
+}
+
+func TestTypeCheckHandWritten(t *testing.T) {
+	outputPath := t.TempDir()
+	files := typeCheckFiles()
+	files["widgets.go"] = bytes.Replace(files["widgets.go"], []byte(`int = "list"`), []byte(`= "list"`), 1)
+
+	// Without the hand-written widgetLabel, the generated code doesn't check
+	var errs CodeErrors
+	if err := TypeCheck(outputPath, files, typeCheckMethods); !errors.As(err, &errs) || !reflect.DeepEqual(errs.Methods(), []string{"WidgetsService.WidgetsShow"}) {
+		t.Fatalf("expected an error in widgetsShowCmd, got %v", err)
+	}
+
+	writeTestFile(t, filepath.Join(outputPath, "hooks_widgets.go"), []byte("package commands\n\nfunc widgetLabel() string { return \"widget\" }\n"))
+	writeTestFile(t, filepath.Join(outputPath, "external_test.go"), []byte("package commands_test\n"))
+	writeTestFile(t, filepath.Join(outputPath, "old.go"), []byte(generatedHeader+"\npackage commands\n\nvar widgetsShowCmd = 1\n"))
+	if err := TypeCheck(outputPath, files, typeCheckMethods); err != nil {
+		t.Fatalf("expected the hand-written file to be checked with the generated ones, got %v", err)
+	}
+
+	// Hand-written code broken by the generated code is reported too
+	writeTestFile(t, filepath.Join(outputPath, "widgets_test.go"), []byte("package commands\n\nvar _ int = widgetsListCmd\n"))
+	err := TypeCheck(outputPath, files, typeCheckMethods)
+	if !errors.As(err, &errs) || len(errs) != 1 || errs[0].File != "widgets_test.go" || errs[0].Method != "" {
+		t.Errorf("expected an error in widgets_test.go, got %v", err)
+	}
+}
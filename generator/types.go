@@ -12,9 +12,12 @@ type Method struct {
 	Params     []Param
 	Returns    []Return
 	HasContext bool
-	// Hidden indicates this method should not generate a CLI command
-	// (typically because it's a helper method used by chaining)
-	Hidden bool
+	// Custom indicates the command is hand-written: only a stub is generated,
+	// to be replaced with ReplaceCommand
+	Custom bool
+	// Results names the non-error returns and selects the rendered ones, from
+	// the manifest. DefaultResults is used when empty.
+	Results []Result
 }
 
 // Param represents a method parameter
@@ -24,20 +27,43 @@ type Param struct {
 	// ChainedFrom indicates this param should be fetched by calling another method first
 	// e.g., logsURL param is chained from LogsURL method
 	ChainedFrom *ChainedParam
+	// ResolvedFrom indicates this ID param can be resolved from a list method
+	// returning the identified type, e.g. a deployment ID from DeploymentList
+	ResolvedFrom *ResolvedParam
 }
 
 // ChainedParam describes how to fetch a parameter value from another method
 type ChainedParam struct {
 	MethodName   string  // Method to call to get this value (e.g., "LogsURL")
 	SourceParams []Param // Parameters needed by the chained method (e.g., "app")
+	ReturnType   string  // Type returned by the chained method (e.g., "*http.Response")
+}
+
+// ResolvedParam describes how to resolve an ID parameter by listing the
+// identified type
+type ResolvedParam struct {
+	Type       string   // Identified type (e.g., "Deployment")
+	Service    string   // Service of the list method (e.g., "DeploymentsService")
+	MethodName string   // List method (e.g., "DeploymentList")
+	Args       []string // Parameters of the method passed to the list method (e.g., "app")
+	CreatedAt  string   // Type of the CreatedAt field of Type, empty if it has none
+	NameFields []string // String fields of Type matched as names (e.g., "Name")
 }
 
 // Return represents a method return type
 type Return struct {
+	Name    string // Name in the SDK signature, empty for unnamed returns
 	Type    string
 	IsError bool
 }
 
+// Result is a named non-error return value of a method
+type Result struct {
+	Name   string // Name used as the key of the JSON envelope (e.g., "status")
+	Type   string
+	Render bool // Whether the value is rendered
+}
+
 // StructField represents a field in a struct (for expanding opts structs)
 type StructField struct {
 	Name     string
@@ -50,6 +76,9 @@ type StructField struct {
 type ParsedStruct struct {
 	Name   string
 	Fields []StructField
+	// Embedded are the embedded fields, named after their type (e.g., "App"
+	// for *App), whose fields are promoted
+	Embedded []StructField `json:",omitempty"`
 }
 
 // StructBuilder represents code to build an SDK struct from CLI flags
@@ -0,0 +1,212 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// diffContext is the number of unchanged lines shown around each change
+const diffContext = 3
+
+// maxDiffEdits bounds the edit search. Larger changes are shown as the
+// removal of all differing lines followed by their replacement.
+const maxDiffEdits = 4000
+
+// diffLine is a line of an edit script
+type diffLine struct {
+	op   byte // ' ' kept, '-' removed or '+' added
+	text string
+}
+
+// unifiedDiff returns the unified diff turning old into new, or an empty
+// string when both are equal
+func unifiedDiff(oldName, newName string, old, new []byte) string {
+	if bytes.Equal(old, new) {
+		return ""
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "--- %s\n+++ %s\n", oldName, newName)
+	lines := diffLines(splitLines(old), splitLines(new))
+	for _, h := range diffHunks(lines) {
+		sb.WriteString(h)
+	}
+	return sb.String()
+}
+
+// noNewline marks a last line missing its newline, which then differs from
+// the same line with one
+const noNewline = "\n\\ No newline at end of file"
+
+func splitLines(b []byte) []string {
+	if len(b) == 0 {
+		return nil
+	}
+	lines := strings.Split(strings.TrimSuffix(string(b), "\n"), "\n")
+	if b[len(b)-1] != '\n' {
+		lines[len(lines)-1] += noNewline
+	}
+	return lines
+}
+
+// diffLines computes a shortest edit script from a to b
+func diffLines(a, b []string) []diffLine {
+	// The common prefix and suffix are kept out of the edit search
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	var lines []diffLine
+	for _, l := range a[:prefix] {
+		lines = append(lines, diffLine{' ', l})
+	}
+	lines = append(lines, myersDiff(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	for _, l := range a[len(a)-suffix:] {
+		lines = append(lines, diffLine{' ', l})
+	}
+	return lines
+}
+
+// myersDiff implements Myers' O(ND) difference algorithm
+func myersDiff(a, b []string) []diffLine {
+	n, m := len(a), len(b)
+	limit := min(n+m, maxDiffEdits)
+	offset := limit + 1
+	v := make([]int, 2*limit+3)
+
+	// trace[d] holds the furthest x reached on diagonals -d-1..d+1 before step d
+	var trace [][]int
+	for d := 0; d <= limit; d++ {
+		trace = append(trace, append([]int(nil), v[offset-d-1:offset+d+2]...))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				return myersBacktrack(a, b, trace, n, m)
+			}
+		}
+	}
+
+	// Too many edits to search: replace the whole block
+	lines := make([]diffLine, 0, n+m)
+	for _, l := range a {
+		lines = append(lines, diffLine{'-', l})
+	}
+	for _, l := range b {
+		lines = append(lines, diffLine{'+', l})
+	}
+	return lines
+}
+
+// myersBacktrack walks the trace back from (x, y) to build the edit script
+func myersBacktrack(a, b []string, trace [][]int, x, y int) []diffLine {
+	var reversed []diffLine
+	for d := len(trace) - 1; d >= 0; d-- {
+		w := trace[d]
+		at := func(k int) int { return w[k+d+1] }
+
+		k := x - y
+		prevK := k - 1
+		if k == -d || (k != d && at(k-1) < at(k+1)) {
+			prevK = k + 1
+		}
+		prevX := at(prevK)
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			reversed = append(reversed, diffLine{' ', a[x-1]})
+			x--
+			y--
+		}
+		if d > 0 {
+			if x == prevX {
+				reversed = append(reversed, diffLine{'+', b[y-1]})
+			} else {
+				reversed = append(reversed, diffLine{'-', a[x-1]})
+			}
+		}
+		x, y = prevX, prevY
+	}
+
+	lines := make([]diffLine, len(reversed))
+	for i, l := range reversed {
+		lines[len(reversed)-1-i] = l
+	}
+	return lines
+}
+
+// diffHunks groups an edit script into unified diff hunks
+func diffHunks(lines []diffLine) []string {
+	var changes []int
+	for i, l := range lines {
+		if l.op != ' ' {
+			changes = append(changes, i)
+		}
+	}
+
+	var hunks []string
+	for i := 0; i < len(changes); {
+		// Merge changes separated by less than two contexts
+		j := i
+		for j+1 < len(changes) && changes[j+1]-changes[j] <= 2*diffContext {
+			j++
+		}
+		start := max(changes[i]-diffContext, 0)
+		end := min(changes[j]+diffContext+1, len(lines))
+		hunks = append(hunks, formatHunk(lines, start, end))
+		i = j + 1
+	}
+	return hunks
+}
+
+// formatHunk formats lines[start:end] as a hunk
+func formatHunk(lines []diffLine, start, end int) string {
+	oldStart, newStart := 1, 1
+	for _, l := range lines[:start] {
+		if l.op != '+' {
+			oldStart++
+		}
+		if l.op != '-' {
+			newStart++
+		}
+	}
+
+	var body strings.Builder
+	oldLen, newLen := 0, 0
+	for _, l := range lines[start:end] {
+		if l.op != '+' {
+			oldLen++
+		}
+		if l.op != '-' {
+			newLen++
+		}
+		body.WriteByte(l.op)
+		body.WriteString(l.text)
+		body.WriteByte('\n')
+	}
+
+	// An empty range starts at the line before it
+	if oldLen == 0 {
+		oldStart--
+	}
+	if newLen == 0 {
+		newStart--
+	}
+	return fmt.Sprintf("@@ -%d,%d +%d,%d @@\n%s", oldStart, oldLen, newStart, newLen, body.String())
+}
@@ -0,0 +1,98 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// numberedLines returns the lines "1" to "n", with line i replaced by
+// replaced[i]
+func numberedLines(n int, replaced map[int]string) []byte {
+	var sb strings.Builder
+	for i := 1; i <= n; i++ {
+		line, ok := replaced[i]
+		if !ok {
+			line = fmt.Sprint(i)
+		}
+		sb.WriteString(line + "\n")
+	}
+	return []byte(sb.String())
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	tests := map[string]struct {
+		old, new string
+		want     string
+	}{
+		"empty":     {},
+		"identical": {old: "a\nb\n", new: "a\nb\n"},
+		"insertion": {
+			old:  "a\nb\nc\n",
+			new:  "a\nb\nx\ny\nc\n",
+			want: "@@ -1,3 +1,5 @@\n a\n b\n+x\n+y\n c\n",
+		},
+		"added file": {
+			new:  "a\nb\n",
+			want: "@@ -0,0 +1,2 @@\n+a\n+b\n",
+		},
+		"removed file": {
+			old:  "a\n",
+			want: "@@ -1,1 +0,0 @@\n-a\n",
+		},
+		"missing trailing newline": {
+			old:  "a\nb\n",
+			new:  "a\nb",
+			want: "@@ -1,2 +1,2 @@\n a\n-b\n+b\n\\ No newline at end of file\n",
+		},
+		"trailing newline added": {
+			old:  "a",
+			new:  "a\n",
+			want: "@@ -1,1 +1,1 @@\n-a\n\\ No newline at end of file\n+a\n",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := unifiedDiff("old", "new", []byte(tt.old), []byte(tt.new))
+
+			want := ""
+			if tt.want != "" {
+				want = "--- old\n+++ new\n" + tt.want
+			}
+			if got != want {
+				t.Errorf("got:\n%s\nwant:\n%s", got, want)
+			}
+		})
+	}
+}
+
+func TestUnifiedDiffHunks(t *testing.T) {
+	tests := map[string]struct {
+		replaced map[int]string
+		want     string
+	}{
+		"merged context": {
+			replaced: map[int]string{5: "five", 11: "eleven"},
+			want:     "@@ -2,13 +2,13 @@\n 2\n 3\n 4\n-5\n+five\n 6\n 7\n 8\n 9\n 10\n-11\n+eleven\n 12\n 13\n 14\n",
+		},
+		"separate hunks": {
+			replaced: map[int]string{5: "five", 13: "thirteen"},
+			want: "@@ -2,7 +2,7 @@\n 2\n 3\n 4\n-5\n+five\n 6\n 7\n 8\n" +
+				"@@ -10,7 +10,7 @@\n 10\n 11\n 12\n-13\n+thirteen\n 14\n 15\n 16\n",
+		},
+		"first and last lines": {
+			replaced: map[int]string{1: "one", 20: "twenty"},
+			want: "@@ -1,4 +1,4 @@\n-1\n+one\n 2\n 3\n 4\n" +
+				"@@ -17,4 +17,4 @@\n 17\n 18\n 19\n-20\n+twenty\n",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := unifiedDiff("old", "new", numberedLines(20, nil), numberedLines(20, tt.replaced))
+
+			if want := "--- old\n+++ new\n" + tt.want; got != want {
+				t.Errorf("got:\n%s\nwant:\n%s", got, want)
+			}
+		})
+	}
+}
@@ -1,24 +1,57 @@
 package generatorcli
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 
 	"generative-cli/generator"
 )
 
-var outputPath string
+var (
+	outputPath   string
+	checkOnly    bool
+	force        bool
+	templatesDir string
+)
 
 var generateCmd = &cobra.Command{
 	Use:   "generate",
 	Short: "Generate CLI commands from manifest",
-	Long:  `Generate Cobra commands based on entries in manifest.toml (manifest is treated as read-only).`,
+	Long: `Generate Cobra commands based on entries in manifest.toml (manifest is treated as read-only).
+
+Only files whose content changed are rewritten. With --check, nothing is written:
+the command prints a unified diff and fails if the output directory is out of date.
+
+The generated package is type-checked against the SDK before anything is written.
+If it doesn't format or type-check, the errors are reported with the manifest
+method they come from and the output directory is left untouched, unless --force
+is passed.
+
+Methods marked custom = true in the manifest only get a stub command, to be
+replaced by a hand-written one registered with ReplaceCommand.
+
+Methods returning several values render the ones marked render = true in their
+manifest results; JSON output wraps them in an object keyed by result name.
+
+With --templates, the named templates found in the directory (e.g. run.tmpl)
+replace the built-in ones; see "templates dump" for a starting point.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		manifest, err := generator.LoadManifest("manifest.toml")
 		if err != nil {
 			return fmt.Errorf("failed to load manifest: %w", err)
 		}
+		generator.ConfigureNaming(manifest.Acronyms)
+
+		templates, err := generator.LoadTemplates(templatesDir)
+		if err != nil {
+			return fmt.Errorf("failed to load templates: %w", err)
+		}
+		if overridden := templates.Overridden(); len(overridden) > 0 {
+			fmt.Printf("Using templates from %s: %s\n", templatesDir, strings.Join(overridden, ", "))
+		}
 
 		// Resolve SDK path and parse for full method signatures (including all return types)
 		resolvedSDKPath, err := resolveSDKPath(sdkPath)
@@ -36,20 +69,33 @@ var generateCmd = &cobra.Command{
 
 		fmt.Printf("Parsed %d services and %d structs from SDK\n", len(services), len(structs))
 
+		if err := generator.CheckColumns(manifest.Columns, structs); err != nil {
+			return fmt.Errorf("invalid manifest columns: %w", err)
+		}
+		generator.ConfigureColumns(manifest.Columns)
+
 		// Detect method chaining patterns (e.g., logsURL param -> LogsURL method)
 		fmt.Println("Detecting method chaining patterns...")
-		services = generator.DetectMethodChaining(services)
+		services = generator.DetectMethodChaining(services, structs)
 
 		// Build a map of methods to generate based on manifest
 		methodsToGen := manifest.MethodsToGenerateSet()
+		customMethods := manifest.CustomMethodsSet()
+		methodResults := manifest.MethodResults()
 
 		// Filter parsed services to only include methods marked for generation
-		// Also include hidden methods that are needed for chaining
 		methods := make(map[string][]generator.Method)
 		for _, svc := range services {
 			for _, method := range svc.Methods {
 				key := svc.Name + "." + method.Name
 				if methodsToGen[key] {
+					method.Custom = customMethods[key]
+					if results, ok := methodResults[key]; ok {
+						if err := generator.CheckResults(method, results); err != nil {
+							return fmt.Errorf("invalid manifest entry %s: %w", key, err)
+						}
+						method.Results = results
+					}
 					methods[svc.Name] = append(methods[svc.Name], method)
 				}
 			}
@@ -62,23 +108,70 @@ var generateCmd = &cobra.Command{
 
 		fmt.Printf("Generating commands for %d methods across %d services\n", countMethods(methods), len(methods))
 
-		// Generate code
-		if err := generator.GenerateCommands(methods, structs, outputPath); err != nil {
+		// Generate code. Code errors are reported after the type check.
+		files, err := generator.RenderCommands(methods, structs, templates)
+		var codeErrs generator.CodeErrors
+		if err != nil && !errors.As(err, &codeErrs) {
 			return fmt.Errorf("failed to generate commands: %w", err)
 		}
 
 		// Generate spec
-		if err := generator.GenerateSpec(methods, outputPath); err != nil {
+		if files["spec.toml"], err = generator.RenderSpec(methods); err != nil {
 			return fmt.Errorf("failed to generate spec: %w", err)
 		}
 
-		fmt.Println("Generation complete!")
+		// Only code that formats can be type-checked
+		if codeErrs == nil {
+			fmt.Println("Type-checking generated code...")
+			if err := generator.TypeCheck(outputPath, files, methods); err != nil && !errors.As(err, &codeErrs) {
+				return fmt.Errorf("failed to type-check generated code: %w", err)
+			}
+		}
+		if codeErrs != nil {
+			cmd.SilenceUsage = true
+			fmt.Fprintln(cmd.ErrOrStderr(), codeErrs)
+			broken := "generated code is broken"
+			if methods := codeErrs.Methods(); len(methods) > 0 {
+				broken = fmt.Sprintf("generated code for %s is broken", strings.Join(methods, ", "))
+			}
+			if checkOnly {
+				return errors.New(broken)
+			}
+			if !force {
+				return fmt.Errorf("%s, %s left untouched (pass --force to write it anyway)", broken, outputPath)
+			}
+			fmt.Fprintf(cmd.ErrOrStderr(), "Writing broken generated code (--force): %s\n", broken)
+		}
+
+		if checkOnly {
+			diff, err := generator.CheckFiles(outputPath, files)
+			if err != nil {
+				return fmt.Errorf("failed to check generated files: %w", err)
+			}
+			if diff != "" {
+				cmd.SilenceUsage = true
+				fmt.Fprint(cmd.OutOrStdout(), diff)
+				return fmt.Errorf("%s is out of date, run generate to update it", outputPath)
+			}
+			fmt.Printf("%s is up to date\n", outputPath)
+			return nil
+		}
+
+		changed, err := generator.WriteFiles(outputPath, files)
+		if err != nil {
+			return fmt.Errorf("failed to write generated files: %w", err)
+		}
+
+		fmt.Printf("Generation complete! %d of %d files changed\n", len(changed), len(files))
 		return nil
 	},
 }
 
 func init() {
 	generateCmd.Flags().StringVarP(&outputPath, "output", "o", "generated/commands", "Output path for generated commands")
+	generateCmd.Flags().BoolVar(&checkOnly, "check", false, "Fail with a diff if the output path differs from what would be generated, without writing")
+	generateCmd.Flags().BoolVar(&force, "force", false, "Write the generated code even if it doesn't format or type-check")
+	generateCmd.Flags().StringVar(&templatesDir, "templates", "", "Directory of <name>.tmpl files overriding the built-in templates")
 }
 
 func countMethods(services map[string][]generator.Method) int {
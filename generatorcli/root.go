@@ -20,6 +20,7 @@ func NewRootCommand() *cobra.Command {
 
 	rootCmd.AddCommand(generateCmd)
 	rootCmd.AddCommand(updateManifestCmd)
+	rootCmd.AddCommand(templatesCmd)
 
 	return rootCmd
 }
@@ -0,0 +1,53 @@
+package generatorcli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"generative-cli/generator"
+)
+
+var overwriteTemplates bool
+
+var templatesCmd = &cobra.Command{
+	Use:   "templates",
+	Short: "List the code generation templates",
+	Long: `List the named templates used to generate commands. Each one can be overridden
+by a <name>.tmpl file in the directory passed to generate --templates.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		for _, name := range generator.TemplateNames() {
+			fmt.Fprintf(cmd.OutOrStdout(), "%-12s %s\n", name, generator.TemplateDescriptions[name])
+		}
+		return nil
+	},
+}
+
+var templatesDumpCmd = &cobra.Command{
+	Use:   "dump [dir]",
+	Short: "Write the built-in templates to a directory",
+	Long: `Write the built-in templates to dir (default "templates") as <name>.tmpl files,
+as a starting point for overrides. Delete the files you don't change so they
+keep following the built-in templates.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dir := "templates"
+		if len(args) > 0 {
+			dir = args[0]
+		}
+
+		written, err := generator.DumpTemplates(dir, overwriteTemplates)
+		if err != nil {
+			return err
+		}
+		for _, path := range written {
+			fmt.Fprintf(cmd.OutOrStdout(), "Wrote %s\n", path)
+		}
+		return nil
+	},
+}
+
+func init() {
+	templatesDumpCmd.Flags().BoolVar(&overwriteTemplates, "force", false, "Overwrite existing template files")
+	templatesCmd.AddCommand(templatesDumpCmd)
+}
@@ -0,0 +1,303 @@
+package render
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// BrowserAction is a command the table browser runs on the selected item
+type BrowserAction struct {
+	Key      string                         // "enter" or a single letter
+	Name     string                         // Name in the help line (e.g., "show")
+	Confirm  bool                           // Whether to ask before running it
+	Describe func(item any) string          // Command line of the action on an item, shown while it runs
+	Run      func(item any) (string, error) // Runs the action, returning the output to show
+}
+
+// Browser is a bubbletea model showing a list as a scrollable table. Typing
+// after / filters the rows, and the actions run on the selected item, their
+// output being shown in place of the table until esc.
+type Browser struct {
+	items   []any
+	rows    []table.Row
+	visible []int // Indexes of the items matching the filter, in table order
+	actions []BrowserAction
+	table   table.Model
+	output  viewport.Model
+
+	filter    string
+	filtering bool
+	confirm   *BrowserAction // Action waiting for y
+	running   string         // Description of the running action, empty when none
+	quitting  bool           // Whether to quit once the running action ends
+	title     string         // Description of the action whose output is shown, empty for the table
+}
+
+// describe returns the command line of the action on item, its name when it
+// has no Describe function
+func (a BrowserAction) describe(item any) string {
+	if a.Describe == nil {
+		return a.Name
+	}
+	return a.Describe(item)
+}
+
+// actionDoneMsg carries the output of an action
+type actionDoneMsg struct {
+	title  string
+	output string
+}
+
+// NewBrowser creates a browser of data, a slice of structs or the first slice
+// of Results, arranged and showing the columns selected by opts
+func NewBrowser(data any, opts Options, actions []BrowserAction) (*Browser, error) {
+	if results, ok := data.(Results); ok {
+		data = nil
+		for _, r := range results {
+			if v := reflect.ValueOf(r.Value); v.Kind() == reflect.Slice {
+				data = r.Value
+				break
+			}
+		}
+	}
+	data, err := Arrange(data, opts)
+	if err != nil {
+		return nil, err
+	}
+	v := reflect.ValueOf(data)
+	if v.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("--interactive needs a list, got %s", v.Kind())
+	}
+	recs, err := extractRecords(v, opts, tableCells)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &Browser{actions: actions, output: viewport.New(0, 0)}
+	b.items = make([]any, v.Len())
+	for i := range b.items {
+		b.items[i] = v.Index(i).Interface()
+	}
+	for _, row := range recs.rows {
+		b.rows = append(b.rows, table.Row(row))
+	}
+
+	columns := make([]table.Column, len(recs.columns))
+	for i, header := range recs.headers() {
+		width := lipgloss.Width(header)
+		for _, row := range recs.rows {
+			width = max(width, lipgloss.Width(row[i]))
+		}
+		columns[i] = table.Column{Title: header, Width: min(width, 40)}
+	}
+
+	styles := table.DefaultStyles()
+	styles.Header = TableHeaderStyle
+	styles.Cell = TableCellStyle
+	styles.Selected = TableSelectedStyle.Bold(true).Foreground(primaryColor).Padding(0)
+	// Letters trigger actions, so only j, k, g and G move besides the arrow keys
+	keys := table.DefaultKeyMap()
+	keys.PageUp = key.NewBinding(key.WithKeys("pgup"))
+	keys.PageDown = key.NewBinding(key.WithKeys("pgdown"))
+	keys.HalfPageUp = key.NewBinding(key.WithKeys("ctrl+u"))
+	keys.HalfPageDown = key.NewBinding(key.WithKeys("ctrl+d"))
+	b.table = table.New(table.WithColumns(columns), table.WithFocused(true), table.WithStyles(styles), table.WithKeyMap(keys))
+	b.applyFilter()
+	return b, nil
+}
+
+// Selected returns the selected item, nil when no row matches the filter
+func (b *Browser) Selected() any {
+	cursor := b.table.Cursor()
+	if cursor < 0 || cursor >= len(b.visible) {
+		return nil
+	}
+	return b.items[b.visible[cursor]]
+}
+
+// Output returns the output of the action shown, empty when the table is shown
+func (b *Browser) Output() string {
+	if b.title == "" {
+		return ""
+	}
+	return b.output.View()
+}
+
+func (b *Browser) Init() tea.Cmd {
+	return nil
+}
+
+func (b *Browser) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		b.table.SetWidth(msg.Width)
+		b.table.SetHeight(max(msg.Height-2, 1))
+		b.output.Width, b.output.Height = msg.Width, max(msg.Height-4, 1)
+		return b, nil
+	case actionDoneMsg:
+		b.running = ""
+		if b.quitting {
+			return b, tea.Quit
+		}
+		b.title = msg.title
+		b.output.SetContent(msg.output)
+		b.output.GotoTop()
+		return b, nil
+	case tea.KeyMsg:
+		return b.handleKey(msg)
+	}
+	return b, nil
+}
+
+// handleKey handles a key press in the current mode: while an action runs,
+// when its output is shown, when typing a filter, when confirming an action,
+// or on the table
+func (b *Browser) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	k := msg.String()
+	if k == "ctrl+c" {
+		return b, tea.Quit
+	}
+
+	switch {
+	case b.running != "":
+		if k == "q" {
+			b.quitting = true
+		}
+		return b, nil
+
+	case b.title != "":
+		switch k {
+		case "esc", "q", "backspace":
+			b.title = ""
+			return b, nil
+		}
+		var cmd tea.Cmd
+		b.output, cmd = b.output.Update(msg)
+		return b, cmd
+
+	case b.filtering:
+		switch msg.Type {
+		case tea.KeyEnter:
+			b.filtering = false
+		case tea.KeyEsc:
+			b.filtering, b.filter = false, ""
+		case tea.KeyBackspace:
+			if runes := []rune(b.filter); len(runes) > 0 {
+				b.filter = string(runes[:len(runes)-1])
+			}
+		case tea.KeyRunes, tea.KeySpace:
+			b.filter += string(msg.Runes)
+		}
+		b.applyFilter()
+		return b, nil
+
+	case b.confirm != nil:
+		action := b.confirm
+		b.confirm = nil
+		if k == "y" || k == "Y" {
+			return b, b.run(*action)
+		}
+		return b, nil
+	}
+
+	switch k {
+	case "q":
+		return b, tea.Quit
+	case "/":
+		b.filtering = true
+		return b, nil
+	case "esc":
+		b.filter = ""
+		b.applyFilter()
+		return b, nil
+	}
+	for _, action := range b.actions {
+		if action.Key != k || b.Selected() == nil {
+			continue
+		}
+		if action.Confirm {
+			b.confirm = &action
+			return b, nil
+		}
+		return b, b.run(action)
+	}
+
+	var cmd tea.Cmd
+	b.table, cmd = b.table.Update(msg)
+	return b, cmd
+}
+
+// run starts an action on the selected item
+func (b *Browser) run(action BrowserAction) tea.Cmd {
+	item := b.Selected()
+	title := action.describe(item)
+	b.running = title
+	return func() tea.Msg {
+		output, err := action.Run(item)
+		output = strings.TrimRight(output, "\n")
+		if err != nil && output == "" {
+			output = RenderError(err)
+		}
+		return actionDoneMsg{title: title, output: output}
+	}
+}
+
+// applyFilter shows the rows with a cell containing the filter, regardless
+// of case
+func (b *Browser) applyFilter() {
+	filter := strings.ToLower(b.filter)
+	b.visible = b.visible[:0]
+	var rows []table.Row
+	for i, row := range b.rows {
+		for _, cell := range row {
+			if strings.Contains(strings.ToLower(cell), filter) {
+				b.visible = append(b.visible, i)
+				rows = append(rows, row)
+				break
+			}
+		}
+	}
+	b.table.SetRows(rows)
+	if b.table.Cursor() >= len(rows) {
+		b.table.SetCursor(max(len(rows)-1, 0))
+	}
+}
+
+func (b *Browser) View() string {
+	if b.title != "" {
+		return TitleStyle.UnsetMarginBottom().Render(b.title) + "\n\n" +
+			b.output.View() + "\n" +
+			SubtitleStyle.Render("esc back · ↑/↓ scroll")
+	}
+	return b.table.View() + "\n" + b.statusLine()
+}
+
+// statusLine describes the current mode, or the keys of the table
+func (b *Browser) statusLine() string {
+	switch {
+	case b.running != "":
+		return SubtitleStyle.Render("Running " + b.running + "…")
+	case b.confirm != nil:
+		return WarningStyle.Render(fmt.Sprintf("Run %s? (y/N)", b.confirm.describe(b.Selected())))
+	case b.filtering:
+		return KeyStyle.UnsetWidth().Render("/") + b.filter + "█"
+	}
+
+	help := []string{"/ filter"}
+	for _, action := range b.actions {
+		help = append(help, action.Key+" "+action.Name)
+	}
+	help = append(help, "q quit")
+	count := fmt.Sprintf("%d items", len(b.items))
+	if len(b.visible) != len(b.items) {
+		count = fmt.Sprintf("%d of %d items matching %q", len(b.visible), len(b.items), b.filter)
+	}
+	return SubtitleStyle.Render(count + " · " + strings.Join(help, " · "))
+}
@@ -0,0 +1,126 @@
+package render
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// newTestBrowser opens a browser of three apps with the given actions
+func newTestBrowser(t *testing.T, actions ...BrowserAction) *Browser {
+	t.Helper()
+	browser, err := NewBrowser([]testApp{{Name: "web-b"}, {Name: "web-a"}, {Name: "api"}}, Options{}, actions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	browser.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	return browser
+}
+
+// press sends keys to a browser, running the commands they return until
+// they are done
+func press(browser *Browser, keys ...string) {
+	for _, k := range keys {
+		msg := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)}
+		switch k {
+		case "enter":
+			msg = tea.KeyMsg{Type: tea.KeyEnter}
+		case "esc":
+			msg = tea.KeyMsg{Type: tea.KeyEsc}
+		case "down":
+			msg = tea.KeyMsg{Type: tea.KeyDown}
+		}
+		_, cmd := browser.Update(msg)
+		for cmd != nil {
+			_, cmd = browser.Update(cmd())
+		}
+	}
+}
+
+// selectedName returns the name of the selected app, "" when there is none
+func selectedName(browser *Browser) string {
+	app, ok := browser.Selected().(testApp)
+	if !ok {
+		return ""
+	}
+	return app.Name
+}
+
+// recordAction is an action recording the names of the apps it runs on
+func recordAction(key string, confirm bool, ran *[]string) BrowserAction {
+	return BrowserAction{
+		Key:      key,
+		Name:     "stats",
+		Confirm:  confirm,
+		Describe: func(item any) string { return "stats " + item.(testApp).Name },
+		Run: func(item any) (string, error) {
+			*ran = append(*ran, item.(testApp).Name)
+			return "stats of " + item.(testApp).Name, nil
+		},
+	}
+}
+
+func TestBrowserFilter(t *testing.T) {
+	tests := map[string]struct {
+		keys []string
+		want string
+	}{
+		"matching":       {keys: []string{"/", "W", "e", "b", "-", "a", "enter"}, want: "web-a"},
+		"cleared by esc": {keys: []string{"/", "a", "p", "i", "enter", "esc"}, want: "web-b"},
+		"no match":       {keys: []string{"/", "x", "enter"}, want: ""},
+		"moving":         {keys: []string{"/", "w", "e", "b", "enter", "down"}, want: "web-a"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			browser := newTestBrowser(t)
+
+			press(browser, tt.keys...)
+
+			if name := selectedName(browser); name != tt.want {
+				t.Errorf("expected %q selected, got %q", tt.want, name)
+			}
+		})
+	}
+}
+
+func TestBrowserShowAction(t *testing.T) {
+	var ran []string
+	browser := newTestBrowser(t, recordAction("enter", false, &ran))
+
+	press(browser, "down", "enter")
+
+	if len(ran) != 1 || ran[0] != "web-a" {
+		t.Fatalf("expected the action run on web-a, got %v", ran)
+	}
+	if out := browser.Output(); !strings.Contains(out, "stats of web-a") {
+		t.Errorf("expected the output of the action, got %q", out)
+	}
+
+	press(browser, "esc")
+	if out := browser.Output(); out != "" {
+		t.Errorf("expected the table back, got %q", out)
+	}
+	if name := selectedName(browser); name != "web-a" {
+		t.Errorf("expected web-a still selected, got %q", name)
+	}
+}
+
+func TestBrowserConfirmAction(t *testing.T) {
+	var ran []string
+	browser := newTestBrowser(t, recordAction("s", true, &ran))
+
+	press(browser, "s")
+	if !strings.Contains(browser.View(), "Run stats web-b? (y/N)") {
+		t.Fatalf("expected a confirmation, got %q", browser.View())
+	}
+	press(browser, "n")
+	if len(ran) != 0 {
+		t.Fatalf("expected no run once cancelled, got %v", ran)
+	}
+
+	press(browser, "s", "y")
+	if len(ran) != 1 || ran[0] != "web-b" {
+		t.Errorf("expected the action run on web-b, got %v", ran)
+	}
+}
@@ -0,0 +1,84 @@
+package render
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/charmbracelet/colorprofile"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+	"golang.org/x/term"
+)
+
+// Values of --color
+const (
+	ColorAuto   = "auto"   // Colors when the terminal supports them, following NO_COLOR and CLICOLOR_FORCE
+	ColorAlways = "always" // Colors even when piped or with NO_COLOR
+	ColorNever  = "never"  // No colors nor text decoration
+)
+
+// Plain makes tables borderless and leaves detail views and errors unboxed,
+// for output that isn't a terminal. ConfigureOutput sets it.
+var Plain bool
+
+// ConfigureOutput sets the color profile of every style and Plain for output
+// written to w. mode is the value of --color; in auto mode, the profile is
+// detected by colorprofile from w and environ (CLICOLOR_FORCE, TERM,
+// COLORTERM), no colors being used when NO_COLOR is set to a non-empty value.
+func ConfigureOutput(w io.Writer, mode string, environ []string) error {
+	var noColor bool
+	var forced []string // environ without NO_COLOR, for --color=always
+	for _, kv := range environ {
+		if value, ok := strings.CutPrefix(kv, "NO_COLOR="); ok {
+			noColor = noColor || value != ""
+			continue
+		}
+		forced = append(forced, kv)
+	}
+
+	profile := colorprofile.Detect(w, environ)
+	switch mode {
+	case ColorAuto:
+		if noColor {
+			profile = colorprofile.Ascii
+		}
+	case ColorAlways:
+		// Env reads the capabilities of the terminal as if w was one
+		profile = max(colorprofile.Detect(w, forced), colorprofile.Env(forced), colorprofile.ANSI)
+	case ColorNever:
+		profile = colorprofile.Ascii
+	default:
+		return fmt.Errorf("invalid --color %q, expected %s, %s or %s", mode, ColorAuto, ColorAlways, ColorNever)
+	}
+	lipgloss.SetColorProfile(termenvProfile(profile))
+
+	f, ok := w.(interface{ Fd() uintptr })
+	Plain = !ok || !term.IsTerminal(int(f.Fd()))
+	return nil
+}
+
+// termenvProfile converts a colorprofile profile to the termenv one lipgloss
+// renders with. termenv.Ascii drops text decoration along with colors.
+func termenvProfile(p colorprofile.Profile) termenv.Profile {
+	switch p {
+	case colorprofile.TrueColor:
+		return termenv.TrueColor
+	case colorprofile.ANSI256:
+		return termenv.ANSI256
+	case colorprofile.ANSI:
+		return termenv.ANSI
+	default:
+		return termenv.Ascii
+	}
+}
+
+// trimLines trims the spaces lipgloss pads the lines of s with, so plain
+// output has no trailing blanks
+func trimLines(s string) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " ")
+	}
+	return strings.Join(lines, "\n")
+}
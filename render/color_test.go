@@ -0,0 +1,148 @@
+package render
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+// usePlain renders plain output, as when stdout isn't a terminal
+func usePlain(t *testing.T) {
+	t.Helper()
+	prev := Plain
+	Plain = true
+	t.Cleanup(func() { Plain = prev })
+}
+
+// testApp is a list item with a name
+type testApp struct {
+	Name string `json:"name"`
+}
+
+// testEvent is a list item with a date
+type testEvent struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// testEvents are two events a day apart
+var testEvents = []testEvent{
+	{ID: "event-1", Name: `deploy, "web"`, CreatedAt: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+	{ID: "event-2", Name: `deploy, "web"`, CreatedAt: time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)},
+}
+
+func TestPlainTable(t *testing.T) {
+	usePlain(t)
+
+	out, err := Render([]testApp{{Name: "web-a"}, {Name: "api"}}, FormatTable, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := "Name\nweb-a\napi"; out != want {
+		t.Errorf("expected:\n%s\ngot:\n%s", want, out)
+	}
+}
+
+func TestPlainPages(t *testing.T) {
+	usePlain(t)
+	var out bytes.Buffer
+	pages := NewPageWriter(&out, FormatTable, Options{})
+
+	for i := range testEvents {
+		if err := pages.Write(testEvents[i : i+1]); err != nil {
+			t.Fatalf("page %d: %v", i+1, err)
+		}
+	}
+
+	want := "ID       Name           CreatedAt\n" +
+		"event-1  deploy, \"web\"  2026-01-01T00:00:00Z\n" +
+		"event-2  deploy, \"web\"  2026-01-02T00:00:00Z\n"
+	if out.String() != want {
+		t.Errorf("expected:\n%s\ngot:\n%s", want, out.String())
+	}
+}
+
+func TestPlainDetail(t *testing.T) {
+	usePlain(t)
+	type container struct {
+		Name   string `json:"name"`
+		Amount int    `json:"amount"`
+	}
+	app := struct {
+		Name       string      `json:"name"`
+		Containers []container `json:"containers"`
+	}{Name: "web", Containers: []container{{Name: "web", Amount: 2}}}
+
+	out, err := Render(app, FormatDetail, Options{Depth: Rules.MaxDepth})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.ContainsAny(out, "╭│╰") {
+		t.Errorf("expected no borders, got:\n%s", out)
+	}
+	for _, want := range []string{"Name: web\n", "\nContainers\n  Name  Amount\n  web   2"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in:\n%s", want, out)
+		}
+	}
+}
+
+func TestPlainError(t *testing.T) {
+	usePlain(t)
+
+	out := RenderError(errors.New("app not found"))
+
+	if want := "Error: app not found"; out != want {
+		t.Errorf("expected %q, got %q", want, out)
+	}
+}
+
+func TestConfigureOutput(t *testing.T) {
+	prevProfile, prevPlain := lipgloss.ColorProfile(), Plain
+	t.Cleanup(func() {
+		lipgloss.SetColorProfile(prevProfile)
+		Plain = prevPlain
+	})
+
+	tests := []struct {
+		name    string
+		mode    string
+		environ []string
+		want    termenv.Profile
+	}{
+		{"piped", ColorAuto, []string{"TERM=xterm-256color"}, termenv.Ascii},
+		{"forced", ColorAuto, []string{"TERM=xterm-256color", "CLICOLOR_FORCE=1"}, termenv.ANSI256},
+		{"no color", ColorAuto, []string{"TERM=xterm-256color", "CLICOLOR_FORCE=1", "NO_COLOR=yes"}, termenv.Ascii},
+		{"empty no color", ColorAuto, []string{"TERM=xterm-256color", "CLICOLOR_FORCE=1", "NO_COLOR="}, termenv.ANSI256},
+		{"always", ColorAlways, []string{"TERM=xterm-256color", "NO_COLOR=yes"}, termenv.ANSI256},
+		{"always dumb", ColorAlways, []string{"TERM=dumb"}, termenv.ANSI},
+		{"never", ColorNever, []string{"TERM=xterm-256color", "CLICOLOR_FORCE=1"}, termenv.Ascii},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			Plain = false
+			if err := ConfigureOutput(&bytes.Buffer{}, tt.mode, tt.environ); err != nil {
+				t.Fatal(err)
+			}
+			if got := lipgloss.ColorProfile(); got != tt.want {
+				t.Errorf("expected profile %v, got %v", tt.want, got)
+			}
+			if !Plain {
+				t.Error("expected plain output to a buffer")
+			}
+		})
+	}
+
+	err := ConfigureOutput(&bytes.Buffer{}, "sometimes", nil)
+	if err == nil || err.Error() != `invalid --color "sometimes", expected auto, always or never` {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,329 @@
+package render
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// Options are the rendering options selected on the command line
+type Options struct {
+	// Columns selects the table columns by field name or JSON key, dotted for
+	// nested fields (e.g., "owner.username")
+	Columns []string
+	// Wide shows every field, nested ones flattened, instead of the preset of
+	// the element type
+	Wide bool
+	// Depth is the number of nested levels detail views expand, 0 showing
+	// every nested value inline
+	Depth int
+	// SortBy and Filters sort and filter the items of lists, see Arrange
+	SortBy  SortKeys
+	Filters Filters
+	// Query is a --query expression applied to the JSON form of the result,
+	// see CheckQuery
+	Query string
+}
+
+// columnPresets are the default table columns by element type name. Types
+// without a preset show every field.
+var columnPresets = map[string][]string{
+	"App":          {"name", "status", "region", "owner.username", "last_deployed_at"},
+	"Addon":        {"id", "addon_provider.name", "plan.name", "status"},
+	"Collaborator": {"email", "username", "status"},
+	"Deployment":   {"id", "status", "git_ref", "user.username", "created_at"},
+	"Domain":       {"name", "ssl", "ssl_status", "validity"},
+	"Variable":     {"name", "value"},
+}
+
+// RegisterColumns sets the default table columns of the element type named
+// typeName (e.g., "App"), replacing its preset. Columns missing from the type
+// are skipped, so presets outlive SDK field removals.
+func RegisterColumns(typeName string, columns ...string) {
+	columnPresets[typeName] = columns
+}
+
+// column is a table column, the field at index in the element struct
+type column struct {
+	header string // Field names, dotted (e.g., "Owner.Username")
+	key    string // JSON keys, dotted (e.g., "owner.username")
+	index  []int
+}
+
+// value returns the column's field of elem, a struct, and false when a nil
+// pointer is met on the way
+func (c column) value(elem reflect.Value) (reflect.Value, bool) {
+	for _, i := range c.index {
+		for elem.Kind() == reflect.Ptr {
+			if elem.IsNil() {
+				return reflect.Value{}, false
+			}
+			elem = elem.Elem()
+		}
+		elem = elem.Field(i)
+	}
+	return elem, true
+}
+
+// selectColumns returns the columns of a table of t structs: the ones selected
+// in opts, every field with opts.Wide, or else the preset of t
+func selectColumns(t reflect.Type, opts Options) ([]column, error) {
+	if len(opts.Columns) > 0 {
+		var columns []column
+		for _, name := range opts.Columns {
+			name = strings.TrimSpace(name)
+			if name == "" {
+				continue
+			}
+			col, ok := resolveColumn(t, name)
+			if !ok {
+				return nil, fmt.Errorf("unknown column %q, available columns: %s", name, strings.Join(columnNames(t), ", "))
+			}
+			columns = append(columns, col)
+		}
+		return columns, nil
+	}
+
+	if preset, ok := columnPresets[t.Name()]; ok && !opts.Wide {
+		var columns []column
+		for _, name := range preset {
+			if col, ok := resolveColumn(t, name); ok {
+				columns = append(columns, col)
+			}
+		}
+		if len(columns) > 0 {
+			return columns, nil
+		}
+	}
+	return fieldColumns(t), nil
+}
+
+// fieldColumns returns a column per exported field of t shown in tables,
+// nested structs being flattened into dotted columns up to Rules.MaxDepth.
+// Maps, and structs nested deeper, are left out.
+func fieldColumns(t reflect.Type) []column {
+	return appendFieldColumns(nil, t, column{}, 0)
+}
+
+func appendFieldColumns(columns []column, t reflect.Type, parent column, depth int) []column {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		col := column{
+			header: joinPath(parent.header, field.Name),
+			key:    joinPath(parent.key, fieldKey(field)),
+			index:  append(slices.Clone(parent.index), i),
+		}
+		switch {
+		case field.Anonymous && nestedStruct(field.Type):
+			// Embedded fields are promoted, keeping the parent's path
+			col.header, col.key = parent.header, parent.key
+			columns = appendFieldColumns(columns, field.Type, col, depth)
+		case nestedStruct(field.Type):
+			if depth < Rules.MaxDepth {
+				columns = appendFieldColumns(columns, field.Type, col, depth+1)
+			}
+		case field.Type.Kind() == reflect.Map:
+			// Maps don't fit in a cell, --columns still selects them
+		default:
+			columns = append(columns, col)
+		}
+	}
+	return columns
+}
+
+// joinPath appends name to a dotted path
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// columnNames returns the names --columns accepts for the default fields of t
+func columnNames(t reflect.Type) []string {
+	var names []string
+	for _, col := range fieldColumns(t) {
+		names = append(names, col.key)
+	}
+	return names
+}
+
+// resolveColumn finds the field of t named by a dotted path, each segment
+// matching a field name or JSON key regardless of case, "_" and "-"
+func resolveColumn(t reflect.Type, path string) (column, bool) {
+	var col column
+	var headers, keys []string
+	for _, segment := range strings.Split(path, ".") {
+		for t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		if t.Kind() != reflect.Struct {
+			return column{}, false
+		}
+		field, ok := lookupField(t, segment)
+		if !ok {
+			return column{}, false
+		}
+		col.index = append(col.index, field.Index...)
+		headers = append(headers, field.Name)
+		keys = append(keys, fieldKey(field))
+		t = field.Type
+	}
+	col.header = strings.Join(headers, ".")
+	col.key = strings.Join(keys, ".")
+	return col, true
+}
+
+// lookupField returns the exported field of t matching name, looking into
+// embedded structs when t has no such field, as fieldColumns does. The index
+// of the field is its path from t.
+func lookupField(t reflect.Type, name string) (reflect.StructField, bool) {
+	name = normalizeColumn(name)
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		if normalizeColumn(field.Name) == name || normalizeColumn(fieldKey(field)) == name {
+			return field, true
+		}
+	}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() || !field.Anonymous || !nestedStruct(field.Type) {
+			continue
+		}
+		embedded := field.Type
+		for embedded.Kind() == reflect.Ptr {
+			embedded = embedded.Elem()
+		}
+		if promoted, ok := lookupField(embedded, name); ok {
+			promoted.Index = append([]int{i}, promoted.Index...)
+			return promoted, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// fieldKey returns the JSON key of a field, or its name when it has none
+func fieldKey(field reflect.StructField) string {
+	key, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if key == "" || key == "-" {
+		return field.Name
+	}
+	return key
+}
+
+// normalizeColumn folds a column name so "last_deployed_at", "last-deployed-at"
+// and "LastDeployedAt" match
+func normalizeColumn(name string) string {
+	return strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(name))
+}
+
+// records are the cells of a slice of structs, the columns selected by
+// Options. They are shared by the formats showing rows of fields: tables, csv,
+// tsv, markdown and raw.
+type records struct {
+	columns []column
+	rows    [][]string
+}
+
+// extractRecords extracts the records of v, a slice of structs or a struct
+// making a single row, with cells formatted in the given style
+func extractRecords(v reflect.Value, opts Options, cells cellStyle) (*records, error) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		v = v.Elem()
+	}
+	elems := []reflect.Value{v}
+	if v.Kind() == reflect.Slice || v.Kind() == reflect.Array {
+		elems = make([]reflect.Value, v.Len())
+		for i := range elems {
+			elems[i] = v.Index(i)
+		}
+	}
+	if len(elems) == 0 {
+		return &records{}, nil
+	}
+
+	// Get columns from the element type, nil elements being empty rows
+	elemType := v.Type()
+	if v.Kind() == reflect.Slice || v.Kind() == reflect.Array {
+		elemType = elemType.Elem()
+	}
+	if elemType.Kind() == reflect.Ptr {
+		elemType = elemType.Elem()
+	}
+	if elemType.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("expected struct elements, got %s", elemType.Kind())
+	}
+	columns, err := selectColumns(elemType, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	recs := &records{columns: columns}
+	for _, elem := range elems {
+		row := make([]string, len(columns))
+		for i, col := range columns {
+			val, ok := col.value(elem)
+			if !ok {
+				row[i] = cells.empty
+				continue
+			}
+			row[i] = cells.format(val)
+		}
+		recs.rows = append(recs.rows, row)
+	}
+	return recs, nil
+}
+
+// headers returns the column headers, field names (e.g., "Owner.Username")
+func (r *records) headers() []string {
+	headers := make([]string, len(r.columns))
+	for i, col := range r.columns {
+		headers[i] = col.header
+	}
+	return headers
+}
+
+// keys returns the column keys, JSON keys (e.g., "owner.username")
+func (r *records) keys() []string {
+	keys := make([]string, len(r.columns))
+	for i, col := range r.columns {
+		keys[i] = col.key
+	}
+	return keys
+}
+
+// cellStyle is how records format their cells
+type cellStyle struct {
+	empty string // Stands for nil and empty values
+	full  bool   // Whether values are kept whole, see fullValue
+}
+
+// Cell styles of tables, following Rules, and of the formats read by other
+// programs, keeping values whole
+var (
+	tableCells    = cellStyle{empty: "-"}
+	markdownCells = cellStyle{empty: "-", full: true}
+	dataCells     = cellStyle{full: true}
+)
+
+// format formats a cell, the empty string standing for nil and empty values
+func (c cellStyle) format(v reflect.Value) string {
+	s := inlineValue(v)
+	if c.full {
+		s = fullValue(v)
+	}
+	if s == "" && v.Kind() != reflect.String {
+		return c.empty
+	}
+	return s
+}
@@ -0,0 +1,47 @@
+package render
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// ResourceBase is embedded in testResource, its fields being promoted. It is
+// exported as unexported embedded structs are left out.
+type ResourceBase struct {
+	ID    string `json:"id"`
+	Owner struct {
+		Username string `json:"username"`
+	} `json:"owner"`
+}
+
+type testResource struct {
+	*ResourceBase
+	Name string `json:"name"`
+}
+
+func TestColumnsEmbedded(t *testing.T) {
+	base := &ResourceBase{ID: "res-1"}
+	base.Owner.Username = "alice"
+	items := []testResource{{ResourceBase: base, Name: "web"}, {Name: "orphan"}}
+
+	for _, name := range columnNames(reflect.TypeOf(testResource{})) {
+		t.Run(name, func(t *testing.T) {
+			if _, err := selectColumns(reflect.TypeOf(testResource{}), Options{Columns: []string{name}}); err != nil {
+				t.Errorf("expected the available column %s to resolve: %v", name, err)
+			}
+		})
+	}
+
+	recs, err := extractRecords(reflect.ValueOf(items), Options{Columns: []string{"ID", "owner.username", "name"}}, tableCells)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(recs.keys(), ","); got != "id,owner.username,name" {
+		t.Errorf("expected promoted keys, got %s", got)
+	}
+	want := [][]string{{"res-1", "alice", "web"}, {"-", "-", "orphan"}}
+	if !reflect.DeepEqual(recs.rows, want) {
+		t.Errorf("expected rows %q, got %q", want, recs.rows)
+	}
+}
@@ -3,25 +3,33 @@ package render
 import (
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
-	"time"
 
 	"github.com/charmbracelet/lipgloss"
 )
 
-// DetailRenderer renders a single struct as a key-value detail view
+// DetailRenderer renders a single struct as a detail view: a tree of fields
+// where nested structs and maps are indented sections and slices of structs
+// sub-tables
 type DetailRenderer struct {
-	title  string
-	fields []fieldPair
+	title string
+	nodes []detailNode
 }
 
-type fieldPair struct {
-	key   string
-	value string
+// detailNode is a field of a detail view, a map entry or a slice item. It
+// shows either a value, children or a table.
+type detailNode struct {
+	key      string
+	value    string
+	item     bool         // Whether the node is a slice item, shown as "- value"
+	children []detailNode // Fields, entries or items of an expanded value
+	table    string       // Sub-table of a slice of structs
 }
 
-// NewDetailRenderer creates a detail renderer from a struct
-func NewDetailRenderer(data any, title string) (*DetailRenderer, error) {
+// NewDetailRenderer creates a detail renderer from a struct, expanding nested
+// values opts.Depth levels deep
+func NewDetailRenderer(data any, title string, opts Options) (*DetailRenderer, error) {
 	v := reflect.ValueOf(data)
 	if v.Kind() == reflect.Ptr {
 		if v.IsNil() {
@@ -34,10 +42,16 @@ func NewDetailRenderer(data any, title string) (*DetailRenderer, error) {
 		return nil, fmt.Errorf("expected struct, got %s", v.Kind())
 	}
 
-	dr := &DetailRenderer{
+	return &DetailRenderer{
 		title: title,
-	}
+		nodes: fieldNodes(v, 0, opts.Depth),
+	}, nil
+}
 
+// fieldNodes returns the nodes of the exported fields of v, a struct at depth.
+// The fields of embedded structs are promoted.
+func fieldNodes(v reflect.Value, depth, maxDepth int) []detailNode {
+	nodes := []detailNode{}
 	t := v.Type()
 	for i := 0; i < t.NumField(); i++ {
 		field := t.Field(i)
@@ -46,16 +60,84 @@ func NewDetailRenderer(data any, title string) (*DetailRenderer, error) {
 		}
 
 		val := v.Field(i)
-		formatted := formatDetailValue(val, 0)
-		if formatted != "" {
-			dr.fields = append(dr.fields, fieldPair{
-				key:   field.Name,
-				value: formatted,
-			})
+		if field.Anonymous && nestedStruct(field.Type) {
+			for val.Kind() == reflect.Ptr && !val.IsNil() {
+				val = val.Elem()
+			}
+			if val.Kind() == reflect.Struct {
+				nodes = append(nodes, fieldNodes(val, depth, maxDepth)...)
+				continue
+			}
 		}
+		nodes = append(nodes, newDetailNode(field.Name, val, depth, maxDepth))
+	}
+	return nodes
+}
+
+// newDetailNode returns the node of v, a value at depth. Nested structs,
+// maps, and slices of structs or longer than Rules.MaxItems are expanded
+// until maxDepth, other values being shown inline.
+func newDetailNode(key string, v reflect.Value, depth, maxDepth int) detailNode {
+	node := detailNode{key: key}
+	for (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) && !v.IsNil() {
+		v = v.Elem()
+	}
+	if depth >= maxDepth || !expandable(v) {
+		node.value = formatDetailValue(v)
+		return node
 	}
 
-	return dr, nil
+	switch v.Kind() {
+	case reflect.Struct:
+		node.children = fieldNodes(v, depth+1, maxDepth)
+	case reflect.Map:
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return inlineValue(keys[i]) < inlineValue(keys[j])
+		})
+		for _, k := range keys {
+			node.children = append(node.children, newDetailNode(inlineValue(k), v.MapIndex(k), depth+1, maxDepth))
+		}
+	default:
+		elem := v.Type().Elem()
+		if nestedStruct(elem) {
+			recs, err := extractRecords(v, Options{}, tableCells)
+			if err != nil {
+				node.value = formatDetailValue(v)
+				return node
+			}
+			node.table = newTable(recs.headers(), recs.rows).Render()
+			return node
+		}
+		for i := 0; i < v.Len(); i++ {
+			child := newDetailNode(fmt.Sprintf("[%d]", i), v.Index(i), depth+1, maxDepth)
+			child.item = child.children == nil && child.table == ""
+			node.children = append(node.children, child)
+		}
+	}
+	return node
+}
+
+// expandable reports whether v is shown as children or a sub-table rather than
+// inline: nested structs, non-empty maps, and non-empty slices of structs,
+// of slices or maps, or longer than Rules.MaxItems
+func expandable(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Struct:
+		return nestedStruct(v.Type())
+	case reflect.Map:
+		return v.Len() > 0
+	case reflect.Slice, reflect.Array:
+		elem := v.Type().Elem()
+		for elem.Kind() == reflect.Ptr {
+			elem = elem.Elem()
+		}
+		if v.Len() == 0 || elem.Kind() == reflect.Uint8 {
+			return false
+		}
+		return v.Len() > Rules.MaxItems || nestedStruct(elem) || elem.Kind() == reflect.Slice || elem.Kind() == reflect.Map
+	}
+	return false
 }
 
 // Render renders the detail view to a string
@@ -66,90 +148,66 @@ func (dr *DetailRenderer) Render() string {
 		sb.WriteString(TitleStyle.Render(dr.title))
 		sb.WriteString("\n\n")
 	}
+	writeDetailNodes(&sb, dr.nodes, "")
 
-	maxKeyLen := 0
-	for _, f := range dr.fields {
-		if len(f.key) > maxKeyLen {
-			maxKeyLen = len(f.key)
-		}
-	}
-
-	for _, f := range dr.fields {
-		key := KeyStyle.Width(maxKeyLen + 2).Render(f.key + ":")
-		value := ValueStyle.Render(f.value)
-		sb.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, key, value))
-		sb.WriteString("\n")
+	if Plain {
+		return trimLines(strings.TrimSuffix(sb.String(), "\n"))
 	}
-
 	return BoxStyle.Render(sb.String())
 }
 
-func formatDetailValue(v reflect.Value, depth int) string {
-	if depth > 2 {
-		return "..."
+// writeDetailNodes writes nodes indented by indent, aligning the values of
+// the nodes showing one
+func writeDetailNodes(sb *strings.Builder, nodes []detailNode, indent string) {
+	maxKeyLen := 0
+	for _, n := range nodes {
+		if n.children == nil && n.table == "" && len(n.key) > maxKeyLen {
+			maxKeyLen = len(n.key)
+		}
 	}
 
-	switch v.Kind() {
-	case reflect.String:
-		s := v.String()
-		if s == "" {
-			return SubtitleStyle.Render("(empty)")
+	for _, n := range nodes {
+		switch {
+		case n.children != nil || n.table != "":
+			sb.WriteString(indent + KeyStyle.UnsetWidth().Render(n.key) + "\n")
+			writeDetailNodes(sb, n.children, indent+"  ")
+			if n.table != "" {
+				for _, line := range strings.Split(n.table, "\n") {
+					sb.WriteString(indent + "  " + line + "\n")
+				}
+			}
+		case n.item:
+			sb.WriteString(indent + SubtitleStyle.Render("-") + " " + ValueStyle.Render(n.value) + "\n")
+		default:
+			key := KeyStyle.Width(maxKeyLen + 2).Render(n.key + ":")
+			value := ValueStyle.Render(n.value)
+			sb.WriteString(indent + lipgloss.JoinHorizontal(lipgloss.Top, key, value) + "\n")
 		}
-		return s
-
-	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		return fmt.Sprintf("%d", v.Int())
-
-	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-		return fmt.Sprintf("%d", v.Uint())
-
-	case reflect.Float32, reflect.Float64:
-		return fmt.Sprintf("%.2f", v.Float())
+	}
+}
 
-	case reflect.Bool:
+// formatDetailValue formats a detail value following Rules, with styled
+// placeholders for nil and empty values
+func formatDetailValue(v reflect.Value) string {
+	for v.Kind() == reflect.Ptr && !v.IsNil() {
+		v = v.Elem()
+	}
+	if v.Kind() == reflect.Bool {
 		if v.Bool() {
 			return SuccessStyle.Render("true")
 		}
 		return ErrorStyle.Render("false")
+	}
+	if s := inlineValue(v); s != "" {
+		return s
+	}
 
-	case reflect.Ptr:
-		if v.IsNil() {
-			return SubtitleStyle.Render("(nil)")
-		}
-		return formatDetailValue(v.Elem(), depth)
-
-	case reflect.Slice:
-		if v.Len() == 0 {
-			return SubtitleStyle.Render("(empty)")
-		}
-		if v.Len() > 5 {
-			return fmt.Sprintf("[%d items]", v.Len())
-		}
-		var items []string
-		for i := 0; i < v.Len(); i++ {
-			items = append(items, formatDetailValue(v.Index(i), depth+1))
-		}
-		return strings.Join(items, ", ")
-
-	case reflect.Map:
-		if v.Len() == 0 {
-			return SubtitleStyle.Render("(empty)")
-		}
-		return fmt.Sprintf("{%d entries}", v.Len())
-
-	case reflect.Struct:
-		// Handle time.Time specially
-		if v.Type() == reflect.TypeOf(time.Time{}) {
-			t := v.Interface().(time.Time)
-			if t.IsZero() {
-				return SubtitleStyle.Render("(not set)")
-			}
-			return t.Format(time.RFC3339)
-		}
-		// For other structs, show type name
-		return fmt.Sprintf("<%s>", v.Type().Name())
-
+	switch {
+	case v.Kind() == reflect.Ptr && v.IsNil():
+		return SubtitleStyle.Render("(nil)")
+	case v.Type() == timeType:
+		return SubtitleStyle.Render("(not set)")
 	default:
-		return fmt.Sprintf("%v", v.Interface())
+		return SubtitleStyle.Render("(empty)")
 	}
 }
@@ -0,0 +1,129 @@
+package render
+
+import (
+	"strings"
+	"testing"
+)
+
+type detailAddress struct {
+	City    string `json:"city"`
+	Country string `json:"country"`
+}
+
+type detailOwner struct {
+	Username string         `json:"username"`
+	Address  *detailAddress `json:"address"`
+}
+
+type detailContainer struct {
+	Name   string `json:"name"`
+	Amount int    `json:"amount"`
+}
+
+// detailApp is an app with every kind of nested value
+type detailApp struct {
+	Name       string            `json:"name"`
+	Owner      detailOwner       `json:"owner"`
+	Flags      map[string]bool   `json:"flags"`
+	Links      map[string]string `json:"links"`
+	Containers []detailContainer `json:"containers"`
+	Domains    []string          `json:"domains"`
+	Tags       []string          `json:"tags"`
+	Stacks     [][]string        `json:"stacks"`
+}
+
+// testDetailApp is a detailApp with every field set
+var testDetailApp = &detailApp{
+	Name:       "web",
+	Owner:      detailOwner{Username: "alice", Address: &detailAddress{City: "Strasbourg", Country: "FR"}},
+	Flags:      map[string]bool{"sticky-session": true, "force-https": false},
+	Links:      map[string]string{},
+	Containers: []detailContainer{{Name: "web", Amount: 2}, {Name: "worker", Amount: 1}},
+	Domains:    strings.Fields("a.io b.io c.io d.io e.io f.io"),
+	Tags:       []string{"prod", "eu"},
+	Stacks:     [][]string{{"scalingo-22"}},
+}
+
+// renderDetail renders testDetailApp expanded to depth
+func renderDetail(t *testing.T, depth int) string {
+	t.Helper()
+	out, err := Render(testDetailApp, FormatDetail, Options{Depth: depth})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return out
+}
+
+// detailLines returns the lines of a detail view, without the box borders and
+// trailing spaces
+func detailLines(out string) []string {
+	var lines []string
+	for _, line := range strings.Split(out, "\n") {
+		line = strings.TrimRight(strings.Trim(line, "│╭╮╰╯─"), " ")
+		if strings.TrimSpace(line) != "" {
+			lines = append(lines, strings.TrimPrefix(line, "  "))
+		}
+	}
+	return lines
+}
+
+// assertLines checks that the lines of want appear in out in order
+func assertLines(t *testing.T, out string, want ...string) {
+	t.Helper()
+	got := detailLines(out)
+	i := 0
+	for _, line := range got {
+		if i < len(want) && line == want[i] {
+			i++
+		}
+	}
+	if i < len(want) {
+		t.Errorf("line %q missing, got:\n%s", want[i], strings.Join(got, "\n"))
+	}
+}
+
+func TestDetailTree(t *testing.T) {
+	out := renderDetail(t, Rules.MaxDepth)
+
+	assertLines(t, out,
+		"Name:  web",
+		"Owner",
+		"  Username: alice",
+		"  Address",
+		"    City:    Strasbourg",
+		"    Country: FR",
+		"Flags",
+		"  force-https:    false",
+		"  sticky-session: true",
+		"Links: (empty)",
+		"Containers",
+		"  │ Name   │ Amount │",
+		"  │ worker │ 1      │",
+		"Domains",
+		"  - a.io",
+		"  - f.io",
+		"Tags:  prod, eu",
+		"Stacks",
+		"  - scalingo-22",
+	)
+}
+
+func TestDetailDepth(t *testing.T) {
+	shallow := renderDetail(t, 1)
+	inline := renderDetail(t, 0)
+
+	assertLines(t, shallow,
+		"Owner",
+		"  Username: alice",
+		"  Address:  <detailAddress>",
+		"Domains",
+		"  - a.io",
+	)
+	assertLines(t, inline,
+		"Name:       web",
+		"Owner:      <detailOwner>",
+		"Flags:      {2 entries}",
+		"Containers: [2 items]",
+		"Domains:    [6 items]",
+	)
+}
@@ -7,23 +7,12 @@ import (
 	"github.com/charmbracelet/lipgloss"
 )
 
-var (
-	errorBoxStyle = lipgloss.NewStyle().
-			Border(lipgloss.RoundedBorder()).
-			BorderForeground(lipgloss.Color("#EF4444")).
-			Padding(0, 1).
-			MarginTop(1)
-
-	errorTitleStyle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(lipgloss.Color("#EF4444"))
-
-	errorMsgStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#FCA5A5"))
-)
-
-// RenderError renders an error with nice formatting
+// RenderError renders an error with nice formatting, on a single line when
+// Plain
 func RenderError(err error) string {
+	if Plain {
+		return errorTitleStyle.Render("Error:") + " " + errorMsgStyle.Render(err.Error())
+	}
 	var sb strings.Builder
 	sb.WriteString(errorTitleStyle.Render("Error"))
 	sb.WriteString("\n")
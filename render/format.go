@@ -0,0 +1,148 @@
+package render
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FormatRules are the rules the table and detail renderers follow to show
+// field values on a single line and to flatten nested structs into dotted
+// columns (e.g., "Owner.Username")
+type FormatRules struct {
+	// TimeLayout is the layout of time.Time values
+	TimeLayout string
+	// MaxItems is the number of slice items tables join with ", ". Longer
+	// slices, and slices of structs, show their length instead.
+	MaxItems int
+	// MaxDepth is the number of nested struct levels flattened into dotted
+	// table columns, deeper structs being left out, and the default --depth
+	// of detail views
+	MaxDepth int
+}
+
+// Rules are the format rules used by every renderer. Hand-written code may
+// change them before commands run.
+var Rules = FormatRules{
+	TimeLayout: time.RFC3339,
+	MaxItems:   5,
+	MaxDepth:   2,
+}
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	stringerType = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+)
+
+// nestedStruct reports whether fields of type t are flattened into dotted
+// fields: structs and pointers to structs, except times and fmt.Stringers
+// which are shown inline
+func nestedStruct(t reflect.Type) bool {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.Struct && t != timeType && !t.Implements(stringerType)
+}
+
+// inlineValue formats v on a single line following Rules. It returns "" for
+// nil pointers, zero times and empty values, each renderer showing those its
+// own way.
+func inlineValue(v reflect.Value) string {
+	return formatValue(v, false)
+}
+
+// fullValue formats v on a single line like inlineValue, for the formats read
+// by other programs: floats keep their precision, slices every item, and
+// structs, maps and slices of them are written as JSON.
+func fullValue(v reflect.Value) string {
+	return formatValue(v, true)
+}
+
+// formatValue formats v on a single line, whole or following Rules
+func formatValue(v reflect.Value, full bool) string {
+	switch v.Kind() {
+	case reflect.Invalid:
+		return ""
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return ""
+		}
+		return formatValue(v.Elem(), full)
+	}
+
+	if v.Type() == timeType {
+		t := v.Interface().(time.Time)
+		if t.IsZero() {
+			return ""
+		}
+		return t.Format(Rules.TimeLayout)
+	}
+	if v.Type().Implements(stringerType) && v.CanInterface() {
+		return v.Interface().(fmt.Stringer).String()
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return fmt.Sprintf("%d", v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return fmt.Sprintf("%d", v.Uint())
+	case reflect.Float32, reflect.Float64:
+		if full {
+			return strconv.FormatFloat(v.Float(), 'f', -1, v.Type().Bits())
+		}
+		return fmt.Sprintf("%.2f", v.Float())
+	case reflect.Bool:
+		if v.Bool() {
+			return "true"
+		}
+		return "false"
+	case reflect.Slice, reflect.Array:
+		if v.Len() == 0 {
+			return ""
+		}
+		elem := v.Type().Elem()
+		nested := nestedStruct(elem) || elem.Kind() == reflect.Slice || elem.Kind() == reflect.Map
+		switch {
+		case full && nested:
+			return jsonInline(v)
+		case !full && (nested || v.Len() > Rules.MaxItems):
+			return fmt.Sprintf("[%d items]", v.Len())
+		}
+		items := make([]string, v.Len())
+		for i := range items {
+			items[i] = formatValue(v.Index(i), full)
+		}
+		return strings.Join(items, ", ")
+	case reflect.Map:
+		if v.Len() == 0 {
+			return ""
+		}
+		if full {
+			return jsonInline(v)
+		}
+		return fmt.Sprintf("{%d entries}", v.Len())
+	case reflect.Struct:
+		if full {
+			return jsonInline(v)
+		}
+		return fmt.Sprintf("<%s>", v.Type().Name())
+	default:
+		return fmt.Sprintf("%v", v.Interface())
+	}
+}
+
+// jsonInline returns the compact JSON form of v, its type name in angle
+// brackets when it can't be marshalled
+func jsonInline(v reflect.Value) string {
+	if v.CanInterface() {
+		if b, err := json.Marshal(v.Interface()); err == nil {
+			return string(b)
+		}
+	}
+	return fmt.Sprintf("<%s>", v.Type().Name())
+}
@@ -0,0 +1,243 @@
+package render
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// Formats are the formats --output accepts
+var Formats = []OutputFormat{FormatTable, FormatDetail, FormatJSON, FormatNDJSON, FormatYAML, FormatCSV, FormatTSV, FormatMarkdown, FormatRaw}
+
+// TemplateFormats are the formats --output accepts followed by "=" and a
+// template (e.g., "jsonpath={.name}")
+var TemplateFormats = []OutputFormat{FormatJSONPath, FormatGoTemplate}
+
+// ParseFormat returns the format named by --output, or an error listing the
+// valid ones. Templates of the template formats are checked too.
+func ParseFormat(name string) (OutputFormat, error) {
+	for _, format := range Formats {
+		if string(format) == name {
+			return format, nil
+		}
+	}
+
+	format, template := OutputFormat(name).split()
+	switch format {
+	case FormatJSONPath, FormatGoTemplate:
+		if !strings.Contains(name, "=") || template == "" {
+			return "", fmt.Errorf("output format %s needs a template, e.g. -o %s='{.name}'", format, format)
+		}
+		check := CheckJSONPath
+		if format == FormatGoTemplate {
+			check = CheckGoTemplate
+		}
+		if err := check(template); err != nil {
+			return "", err
+		}
+		return OutputFormat(name), nil
+	}
+	return "", unknownFormatError(name)
+}
+
+// split splits a template format such as "jsonpath={.name}" into the format
+// and its template. Other formats have no template.
+func (f OutputFormat) split() (OutputFormat, string) {
+	name, template, _ := strings.Cut(string(f), "=")
+	for _, format := range TemplateFormats {
+		if string(format) == name {
+			return format, template
+		}
+	}
+	return f, ""
+}
+
+func unknownFormatError(name string) error {
+	var valid []string
+	for _, format := range Formats {
+		valid = append(valid, string(format))
+	}
+	for _, format := range TemplateFormats {
+		valid = append(valid, string(format)+"=TEMPLATE")
+	}
+	return fmt.Errorf("unknown output format %q, valid formats: %s", name, strings.Join(valid, ", "))
+}
+
+// renderYAML renders data as YAML, with the keys and field order of its JSON
+// encoding
+func renderYAML(data any) (string, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal to JSON: %w", err)
+	}
+	// JSON is YAML: decoding it to a node keeps the order of the fields
+	var node yaml.Node
+	if err := yaml.Unmarshal(b, &node); err != nil {
+		return "", fmt.Errorf("failed to convert to YAML: %w", err)
+	}
+	resetStyle(&node)
+
+	var out bytes.Buffer
+	enc := yaml.NewEncoder(&out)
+	enc.SetIndent(2)
+	if err := enc.Encode(&node); err != nil {
+		return "", fmt.Errorf("failed to marshal to YAML: %w", err)
+	}
+	return strings.TrimSuffix(out.String(), "\n"), nil
+}
+
+// resetStyle drops the flow style and quotes of a node decoded from JSON, so
+// it is encoded as block YAML
+func resetStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetStyle(child)
+	}
+}
+
+// renderDelimited renders the records of data as CSV, or TSV when comma is a
+// tab, with a header of JSON keys
+func renderDelimited(data any, opts Options, comma rune) (string, error) {
+	recs, err := extractRecords(reflect.ValueOf(data), opts, dataCells)
+	if err != nil {
+		return "", err
+	}
+	if len(recs.columns) == 0 {
+		return "", nil
+	}
+	return writeDelimited(append([][]string{recs.keys()}, recs.rows...), comma)
+}
+
+// writeDelimited writes rows of values separated by comma
+func writeDelimited(rows [][]string, comma rune) (string, error) {
+	var out bytes.Buffer
+	w := csv.NewWriter(&out)
+	w.Comma = comma
+	w.WriteAll(rows)
+	if err := w.Error(); err != nil {
+		return "", fmt.Errorf("failed to write %s: %w", delimitedName(comma), err)
+	}
+	return strings.TrimSuffix(out.String(), "\n"), nil
+}
+
+// delimitedName returns the name of the format separating values with comma
+func delimitedName(comma rune) string {
+	if comma == '\t' {
+		return "TSV"
+	}
+	return "CSV"
+}
+
+// renderMarkdown renders the records of data as a Markdown table
+func renderMarkdown(data any, opts Options) (string, error) {
+	recs, err := extractRecords(reflect.ValueOf(data), opts, markdownCells)
+	if err != nil {
+		return "", err
+	}
+	if len(recs.columns) == 0 {
+		return "", nil
+	}
+	return markdownRows([][]string{recs.headers(), markdownRule(len(recs.columns))}) + "\n" + markdownRows(recs.rows), nil
+}
+
+// markdownRule returns the row separating the header of a Markdown table
+func markdownRule(columns int) []string {
+	rule := make([]string, columns)
+	for i := range rule {
+		rule[i] = "---"
+	}
+	return rule
+}
+
+// markdownRows renders rows of a Markdown table, escaping pipes and newlines
+func markdownRows(rows [][]string) string {
+	escape := strings.NewReplacer("|", `\|`, "\n", " ")
+	lines := make([]string, len(rows))
+	for i, row := range rows {
+		cells := make([]string, len(row))
+		for j, cell := range row {
+			cells[j] = escape.Replace(cell)
+		}
+		lines[i] = "| " + strings.Join(cells, " | ") + " |"
+	}
+	return strings.Join(lines, "\n")
+}
+
+// hasRecords reports whether v, once dereferenced, is a struct or a slice of
+// structs, which the row formats render as records. Other values are rendered
+// raw by every format but JSON and YAML.
+func hasRecords(v reflect.Value) bool {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return false
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Struct:
+		return nestedStruct(v.Type())
+	case reflect.Slice, reflect.Array:
+		return nestedStruct(v.Type().Elem())
+	}
+	return false
+}
+
+// renderRaw renders data without styles or headers, for shell pipelines:
+// response bodies and scalars as is, slices one element per line and structs
+// as their tab-separated fields
+func renderRaw(data any, opts Options) (string, error) {
+	if resp, ok := data.(*http.Response); ok {
+		if resp.Body == nil {
+			return "", nil
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return "", fmt.Errorf("failed to read response body: %w", err)
+		}
+		return strings.TrimSuffix(string(body), "\n"), nil
+	}
+
+	v := reflect.ValueOf(data)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return "", nil
+		}
+		v = v.Elem()
+	}
+	switch {
+	case v.Kind() == reflect.Invalid:
+		return "", nil
+	case v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8:
+		return string(v.Bytes()), nil
+	case hasRecords(v):
+		recs, err := extractRecords(v, opts, dataCells)
+		if err != nil {
+			return "", err
+		}
+		return rawRows(recs.rows), nil
+	case v.Kind() == reflect.Slice:
+		lines := make([]string, v.Len())
+		for i := range lines {
+			lines[i] = fullValue(v.Index(i))
+		}
+		return strings.Join(lines, "\n"), nil
+	}
+	return fullValue(v), nil
+}
+
+// rawRows renders rows one per line, their values separated by tabs
+func rawRows(rows [][]string) string {
+	lines := make([]string, len(rows))
+	for i, row := range rows {
+		lines[i] = strings.Join(row, "\t")
+	}
+	return strings.Join(lines, "\n")
+}
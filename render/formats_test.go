@@ -0,0 +1,186 @@
+package render
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// testInvoice is a list item with fields tables shorten
+type testInvoice struct {
+	ID     string   `json:"id"`
+	Amount float64  `json:"amount"`
+	Lines  []string `json:"lines"`
+}
+
+// testInvoices are invoices with a nil one in the middle
+var testInvoices = []*testInvoice{
+	{ID: "inv-1", Amount: 1234.5678, Lines: strings.Fields("a b c d e f")},
+	nil,
+	{ID: "inv-2", Amount: 0.1},
+}
+
+func TestFormatFullValues(t *testing.T) {
+	tests := map[OutputFormat]string{
+		FormatCSV: "id,amount,lines\n" +
+			"inv-1,1234.5678,\"a, b, c, d, e, f\"\n" +
+			",,\n" +
+			"inv-2,0.1,",
+		FormatTSV: "id\tamount\tlines\n" +
+			"inv-1\t1234.5678\ta, b, c, d, e, f\n" +
+			"\t\t\n" +
+			"inv-2\t0.1\t",
+		FormatMarkdown: "| ID | Amount | Lines |\n" +
+			"| --- | --- | --- |\n" +
+			"| inv-1 | 1234.5678 | a, b, c, d, e, f |\n" +
+			"| - | - | - |\n" +
+			"| inv-2 | 0.1 | - |",
+		FormatRaw: "inv-1\t1234.5678\ta, b, c, d, e, f\n" +
+			"\t\t\n" +
+			"inv-2\t0.1\t",
+	}
+	for format, want := range tests {
+		t.Run(string(format), func(t *testing.T) {
+			got, err := Render(testInvoices, format, Options{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != want {
+				t.Errorf("expected:\n%s\ngot:\n%s", want, got)
+			}
+		})
+	}
+}
+
+func TestTableShortValues(t *testing.T) {
+	recs, err := extractRecords(reflect.ValueOf(testInvoices), Options{}, tableCells)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := [][]string{
+		{"inv-1", "1234.57", "[6 items]"},
+		{"-", "-", "-"},
+		{"inv-2", "0.10", "-"},
+	}
+	for i, row := range want {
+		if strings.Join(recs.rows[i], "|") != strings.Join(row, "|") {
+			t.Errorf("row %d: expected %q, got %q", i, row, recs.rows[i])
+		}
+	}
+}
+
+func TestFullValue(t *testing.T) {
+	type line struct {
+		Name string `json:"name"`
+	}
+	tests := []struct {
+		value       any
+		full, short string
+	}{
+		{value: float32(0.1), full: "0.1", short: "0.10"},
+		{value: 1e21, full: "1000000000000000000000", short: "1000000000000000000000.00"},
+		{value: []int{1, 2, 3, 4, 5, 6}, full: "1, 2, 3, 4, 5, 6", short: "[6 items]"},
+		{value: []line{{Name: "a"}}, full: `[{"name":"a"}]`, short: "[1 items]"},
+		{value: map[string]int{"a": 1}, full: `{"a":1}`, short: "{1 entries}"},
+		{value: line{Name: "a"}, full: `{"name":"a"}`, short: "<line>"},
+	}
+	for _, tt := range tests {
+		v := reflect.ValueOf(tt.value)
+		if got := fullValue(v); got != tt.full {
+			t.Errorf("fullValue(%v) = %q, expected %q", tt.value, got, tt.full)
+		}
+		if got := inlineValue(v); got != tt.short {
+			t.Errorf("inlineValue(%v) = %q, expected %q", tt.value, got, tt.short)
+		}
+	}
+}
+
+// writeEventPages writes testEvents in format, one event per page
+func writeEventPages(t *testing.T, format OutputFormat, opts Options) string {
+	t.Helper()
+	var out bytes.Buffer
+	pages := NewPageWriter(&out, format, opts)
+	for i := range testEvents {
+		if err := pages.Write(testEvents[i : i+1]); err != nil {
+			t.Fatalf("page %d: %v", i+1, err)
+		}
+	}
+	return out.String()
+}
+
+func TestFormatPages(t *testing.T) {
+	tests := map[OutputFormat]string{
+		FormatCSV: "id,name,created_at\n" +
+			"event-1,\"deploy, \"\"web\"\"\",2026-01-01T00:00:00Z\n" +
+			"event-2,\"deploy, \"\"web\"\"\",2026-01-02T00:00:00Z\n",
+		FormatTSV: "id\tname\tcreated_at\n" +
+			"event-1\t\"deploy, \"\"web\"\"\"\t2026-01-01T00:00:00Z\n" +
+			"event-2\t\"deploy, \"\"web\"\"\"\t2026-01-02T00:00:00Z\n",
+		FormatMarkdown: "| ID | Name | CreatedAt |\n" +
+			"| --- | --- | --- |\n" +
+			"| event-1 | deploy, \"web\" | 2026-01-01T00:00:00Z |\n" +
+			"| event-2 | deploy, \"web\" | 2026-01-02T00:00:00Z |\n",
+		FormatRaw: "event-1\tdeploy, \"web\"\t2026-01-01T00:00:00Z\n" +
+			"event-2\tdeploy, \"web\"\t2026-01-02T00:00:00Z\n",
+	}
+	for format, want := range tests {
+		t.Run(string(format), func(t *testing.T) {
+			if got := writeEventPages(t, format, Options{}); got != want {
+				t.Errorf("expected:\n%s\ngot:\n%s", want, got)
+			}
+		})
+	}
+}
+
+func TestFormatPagesColumns(t *testing.T) {
+	got := writeEventPages(t, FormatMarkdown, Options{Columns: []string{"id"}})
+
+	if want := "| ID |\n| --- |\n| event-1 |\n| event-2 |\n"; got != want {
+		t.Errorf("expected:\n%s\ngot:\n%s", want, got)
+	}
+}
+
+func TestFormatYAML(t *testing.T) {
+	type variable struct {
+		ID   string
+		Name string
+	}
+	results := Results{{Name: "variable", Value: variable{ID: "var-1", Name: "KEY"}}, {Name: "status", Value: 201}}
+
+	got, err := Render(results, FormatYAML, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "variable:\n" +
+		"  ID: var-1\n" +
+		"  Name: KEY\n" +
+		"status: 201"
+	if got != want {
+		t.Errorf("expected:\n%s\ngot:\n%s", want, got)
+	}
+}
+
+func TestFormatPagesFiltered(t *testing.T) {
+	tests := map[OutputFormat]string{
+		FormatCSV:      "id,name,created_at\nevent-1,\"deploy, \"\"web\"\"\",2026-01-01T00:00:00Z\n",
+		FormatTSV:      "id\tname\tcreated_at\nevent-1\t\"deploy, \"\"web\"\"\"\t2026-01-01T00:00:00Z\n",
+		FormatMarkdown: "| ID | Name | CreatedAt |\n| --- | --- | --- |\n| event-1 | deploy, \"web\" | 2026-01-01T00:00:00Z |\n",
+		FormatRaw:      "event-1\tdeploy, \"web\"\t2026-01-01T00:00:00Z\n",
+	}
+	for format, want := range tests {
+		t.Run(string(format), func(t *testing.T) {
+			var filters Filters
+			if err := filters.Set("id=event-1"); err != nil {
+				t.Fatal(err)
+			}
+
+			// The second page is filtered out
+			if got := writeEventPages(t, format, Options{Filters: filters}); got != want {
+				t.Errorf("expected %q, got %q", want, got)
+			}
+		})
+	}
+}
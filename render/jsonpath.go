@@ -0,0 +1,461 @@
+package render
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// JSONPath templates, as in -o jsonpath='{.items[*].name}', mix text with
+// expressions in braces applied to the JSON form of a result:
+//
+//	{.name} {$.owner.username} {['last-deployed-at']}   fields
+//	{[0]} {[-1]} {[*]} {.*} {..name}                    indexes, wildcards, descent
+//	{[?(@.status == "running")].name}                    filters
+//	{range .[*]}{.name}{"\n"}{end}                       loops, quoted text
+//
+// An expression producing several values joins them with spaces.
+
+// jsonPathNode is a parsed part of a JSONPath template
+type jsonPathNode struct {
+	text string         // Literal text, when path is nil
+	path []jsonPathStep // Expression
+	loop bool           // Whether the node is a {range}
+	body []jsonPathNode // Nodes repeated for each value of path, in a range
+}
+
+// jsonPathStep selects values from the values of the previous step
+type jsonPathStep func(values []any, root any) ([]any, error)
+
+// renderJSONPath executes a JSONPath template on the JSON form of data
+func renderJSONPath(data any, template string) (string, error) {
+	nodes, err := parseJSONPath(template)
+	if err != nil {
+		return "", err
+	}
+	root, err := jsonValue(data)
+	if err != nil {
+		return "", err
+	}
+	var sb strings.Builder
+	if err := executeJSONPath(&sb, nodes, root, root); err != nil {
+		return "", fmt.Errorf("jsonpath %s: %w", template, err)
+	}
+	return sb.String(), nil
+}
+
+func executeJSONPath(sb *strings.Builder, nodes []jsonPathNode, current, root any) error {
+	for _, node := range nodes {
+		if node.path == nil {
+			sb.WriteString(node.text)
+			continue
+		}
+		values, err := evalJSONPath(node.path, current, root)
+		if err != nil {
+			return err
+		}
+		if node.loop {
+			for _, v := range values {
+				if err := executeJSONPath(sb, node.body, v, root); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		for i, v := range values {
+			if i > 0 {
+				sb.WriteString(" ")
+			}
+			s, err := queryValueString(v, false)
+			if err != nil {
+				return err
+			}
+			sb.WriteString(s)
+		}
+	}
+	return nil
+}
+
+func evalJSONPath(path []jsonPathStep, current, root any) ([]any, error) {
+	values := []any{current}
+	for _, step := range path {
+		var err error
+		if values, err = step(values, root); err != nil {
+			return nil, err
+		}
+	}
+	return values, nil
+}
+
+// CheckJSONPath reports syntax errors in a JSONPath template
+func CheckJSONPath(template string) error {
+	_, err := parseJSONPath(template)
+	return err
+}
+
+// parseJSONPath splits a template into text and expressions, nesting the
+// nodes between {range} and {end}
+func parseJSONPath(template string) ([]jsonPathNode, error) {
+	type frame struct {
+		node  jsonPathNode
+		nodes []jsonPathNode
+	}
+	stack := []frame{{}}
+	add := func(node jsonPathNode) {
+		top := &stack[len(stack)-1]
+		top.nodes = append(top.nodes, node)
+	}
+
+	rest := template
+	for rest != "" {
+		open := strings.IndexByte(rest, '{')
+		if open < 0 {
+			add(jsonPathNode{text: rest})
+			break
+		}
+		if open > 0 {
+			add(jsonPathNode{text: rest[:open]})
+		}
+		end := closingBrace(rest, open)
+		if end < 0 {
+			return nil, fmt.Errorf("invalid jsonpath %s: unclosed {", template)
+		}
+		action := strings.TrimSpace(rest[open+1 : end])
+		rest = rest[end+1:]
+
+		switch {
+		case action == "end":
+			if len(stack) == 1 {
+				return nil, fmt.Errorf("invalid jsonpath %s: {end} without {range}", template)
+			}
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			top.node.body = top.nodes
+			add(top.node)
+		case strings.HasPrefix(action, "range "):
+			path, err := parseJSONPathExpr(strings.TrimSpace(strings.TrimPrefix(action, "range ")))
+			if err != nil {
+				return nil, fmt.Errorf("invalid jsonpath %s: %w", template, err)
+			}
+			stack = append(stack, frame{node: jsonPathNode{path: path, loop: true}})
+		case strings.HasPrefix(action, `"`) || strings.HasPrefix(action, "'"):
+			text, err := unquote(action)
+			if err != nil {
+				return nil, fmt.Errorf("invalid jsonpath %s: invalid text %s", template, action)
+			}
+			add(jsonPathNode{text: text})
+		default:
+			path, err := parseJSONPathExpr(action)
+			if err != nil {
+				return nil, fmt.Errorf("invalid jsonpath %s: %w", template, err)
+			}
+			add(jsonPathNode{path: path})
+		}
+	}
+	if len(stack) > 1 {
+		return nil, fmt.Errorf("invalid jsonpath %s: {range} without {end}", template)
+	}
+	return stack[0].nodes, nil
+}
+
+// closingBrace returns the index of the brace closing the one at open,
+// skipping quoted text, or -1
+func closingBrace(s string, open int) int {
+	var quote byte
+	for i := open + 1; i < len(s); i++ {
+		switch c := s[i]; {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '}':
+			return i
+		}
+	}
+	return -1
+}
+
+// unquote unquotes a double or single quoted string
+func unquote(s string) (string, error) {
+	if strings.HasPrefix(s, "'") && strings.HasSuffix(s, "'") && len(s) >= 2 {
+		s = `"` + strings.ReplaceAll(strings.ReplaceAll(s[1:len(s)-1], `"`, `\"`), `\'`, `'`) + `"`
+	}
+	return strconv.Unquote(s)
+}
+
+// parseJSONPathExpr parses an expression such as $.items[*].name
+func parseJSONPathExpr(expr string) ([]jsonPathStep, error) {
+	var steps []jsonPathStep
+	rest := expr
+	switch {
+	case strings.HasPrefix(rest, "$"):
+		steps = append(steps, func(_ []any, root any) ([]any, error) { return []any{root}, nil })
+		rest = rest[1:]
+	case strings.HasPrefix(rest, "@"):
+		rest = rest[1:]
+	}
+
+	for rest != "" {
+		switch {
+		case strings.HasPrefix(rest, ".."):
+			name, n := jsonPathName(rest[2:])
+			if name == "" {
+				return nil, fmt.Errorf("expected a field name after .. in %s", expr)
+			}
+			steps = append(steps, descendStep(name))
+			rest = rest[2+n:]
+		case strings.HasPrefix(rest, ".*"):
+			steps = append(steps, wildcardStep)
+			rest = rest[2:]
+		case strings.HasPrefix(rest, "."):
+			name, n := jsonPathName(rest[1:])
+			rest = rest[1+n:]
+			if name != "" {
+				steps = append(steps, fieldsStep(name))
+			}
+		case strings.HasPrefix(rest, "["):
+			end := closingBracket(rest)
+			if end < 0 {
+				return nil, fmt.Errorf("unclosed [ in %s", expr)
+			}
+			step, err := parseJSONPathBracket(strings.TrimSpace(rest[1:end]))
+			if err != nil {
+				return nil, err
+			}
+			steps = append(steps, step)
+			rest = rest[end+1:]
+		default:
+			return nil, fmt.Errorf("unexpected %q in %s", rest, expr)
+		}
+	}
+	return steps, nil
+}
+
+// jsonPathName returns the field name at the start of s and its length
+func jsonPathName(s string) (string, int) {
+	n := strings.IndexAny(s, ".[")
+	if n < 0 {
+		n = len(s)
+	}
+	return s[:n], n
+}
+
+// closingBracket returns the index of the bracket closing the one starting s,
+// skipping quoted text and nested brackets, or -1
+func closingBracket(s string) int {
+	depth := 0
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '[':
+			depth++
+		case c == ']':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// parseJSONPathBracket parses the inside of [*], [n], ['name'] and [?(filter)]
+func parseJSONPathBracket(inner string) (jsonPathStep, error) {
+	switch {
+	case inner == "*":
+		return wildcardStep, nil
+	case strings.HasPrefix(inner, "'") || strings.HasPrefix(inner, `"`):
+		name, err := unquote(inner)
+		if err != nil {
+			return nil, fmt.Errorf("invalid field name %s", inner)
+		}
+		return fieldsStep(name), nil
+	case strings.HasPrefix(inner, "?(") && strings.HasSuffix(inner, ")"):
+		return parseJSONPathFilter(strings.TrimSpace(inner[2 : len(inner)-1]))
+	}
+	n, err := strconv.Atoi(inner)
+	if err != nil {
+		return nil, fmt.Errorf("unsupported subscript [%s]", inner)
+	}
+	return func(values []any, _ any) ([]any, error) {
+		var out []any
+		for _, v := range values {
+			items, ok := v.([]any)
+			if !ok {
+				continue
+			}
+			i := n
+			if i < 0 {
+				i += len(items)
+			}
+			if i >= 0 && i < len(items) {
+				out = append(out, items[i])
+			}
+		}
+		return out, nil
+	}, nil
+}
+
+// parseJSONPathFilter parses @.path, or @.path followed by a comparison with a
+// literal, keeping the items for which it holds
+func parseJSONPathFilter(filter string) (jsonPathStep, error) {
+	left, op, right := filter, "", ""
+	if i, candidate := filterOperator(filter); i >= 0 {
+		left, op, right = strings.TrimSpace(filter[:i]), candidate, strings.TrimSpace(filter[i+len(candidate):])
+	}
+	if !strings.HasPrefix(left, "@") {
+		return nil, fmt.Errorf("filter %s must start with @", filter)
+	}
+	path, err := parseJSONPathExpr(left)
+	if err != nil {
+		return nil, err
+	}
+	var literal any
+	if op != "" {
+		if literal, err = jsonPathLiteral(right); err != nil {
+			return nil, fmt.Errorf("invalid filter %s: %w", filter, err)
+		}
+	}
+
+	return func(values []any, root any) ([]any, error) {
+		var out []any
+		for _, v := range values {
+			items, err := wildcardStep([]any{v}, root)
+			if err != nil {
+				return nil, err
+			}
+			for _, item := range items {
+				got, err := evalJSONPath(path, item, root)
+				if err != nil {
+					return nil, err
+				}
+				if len(got) == 0 {
+					continue
+				}
+				keep := truthy(got[0])
+				if op != "" {
+					if keep, err = compareOp(op, got[0], literal); err != nil {
+						continue
+					}
+				}
+				if keep {
+					out = append(out, item)
+				}
+			}
+		}
+		return out, nil
+	}, nil
+}
+
+// filterOperator returns the index of the first comparison operator of a
+// filter outside quoted text, and the operator, or -1
+func filterOperator(filter string) (int, string) {
+	var quote byte
+	for i := 0; i < len(filter); i++ {
+		switch c := filter[i]; {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		default:
+			for _, op := range []string{"==", "!=", "<=", ">=", "<", ">"} {
+				if strings.HasPrefix(filter[i:], op) {
+					return i, op
+				}
+			}
+		}
+	}
+	return -1, ""
+}
+
+// jsonPathLiteral parses a quoted string, number, true, false or null
+func jsonPathLiteral(s string) (any, error) {
+	switch s {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	}
+	if strings.HasPrefix(s, "'") || strings.HasPrefix(s, `"`) {
+		return unquote(s)
+	}
+	if _, err := strconv.ParseFloat(s, 64); err != nil {
+		return nil, fmt.Errorf("invalid literal %s", s)
+	}
+	return json.Number(s), nil
+}
+
+// fieldsStep selects the field name of objects
+func fieldsStep(name string) jsonPathStep {
+	return func(values []any, _ any) ([]any, error) {
+		var out []any
+		for _, v := range values {
+			if obj, ok := v.(map[string]any); ok {
+				if field, ok := obj[name]; ok {
+					out = append(out, field)
+				}
+			}
+		}
+		return out, nil
+	}
+}
+
+// wildcardStep selects the items of arrays and the values of objects
+func wildcardStep(values []any, _ any) ([]any, error) {
+	var out []any
+	for _, v := range values {
+		switch v.(type) {
+		case []any, map[string]any:
+			items, err := iterate(v)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, items...)
+		}
+	}
+	return out, nil
+}
+
+// descendStep selects the fields name found at any depth
+func descendStep(name string) jsonPathStep {
+	var descend func(v any, out []any) []any
+	descend = func(v any, out []any) []any {
+		if obj, ok := v.(map[string]any); ok {
+			if field, ok := obj[name]; ok {
+				out = append(out, field)
+			}
+		}
+		if items, err := wildcardStep([]any{v}, nil); err == nil {
+			for _, item := range items {
+				out = descend(item, out)
+			}
+		}
+		return out
+	}
+	return func(values []any, _ any) ([]any, error) {
+		var out []any
+		for _, v := range values {
+			out = descend(v, out)
+		}
+		return out, nil
+	}
+}
@@ -0,0 +1,85 @@
+package render
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderJSONPath(t *testing.T) {
+	items := []map[string]any{
+		{"name": "web", "status": "running", "op": "==", "amount": 2, "owner": map[string]any{"username": "alice"}},
+		{"name": "api", "status": "stopped", "op": "<", "amount": 1, "owner": map[string]any{"username": "bob"}},
+	}
+	tests := map[string]string{
+		"{[0].name}":           "web",
+		"{[-1].name}":          "api",
+		"{[*].owner.username}": "alice bob",
+		"{..username}":         "alice bob",
+		"{$[1]['name']}":       "api",
+		`{range .[*]}{.name}:{.amount}{"\n"}{end}`:       "web:2\napi:1\n",
+		`{[?(@.status == "running")].name}`:              "web",
+		`{[?(@.status != 'running')].name}`:              "api",
+		`{[?(@.amount >= 2)].name}`:                      "web",
+		`{[?(@.amount < 2)].name}`:                       "api",
+		`{[?(@.op == "==")].name}`:                       "web",
+		`{[?(@.op != "==")].name}`:                       "api",
+		`{[?(@.op == '<')].name}`:                        "api",
+		`{[?(@.owner.username == "a\"b")].name}`:         "",
+		`{[?(@.owner)].name}`:                            "web api",
+		`{[?(@.missing)].name}`:                          "",
+		`{[?(@['status'] == "stopped")].owner.username}`: "bob",
+	}
+	for template, want := range tests {
+		t.Run(template, func(t *testing.T) {
+			got, err := renderJSONPath(items, template)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != want {
+				t.Errorf("expected %q, got %q", want, got)
+			}
+		})
+	}
+}
+
+func TestCheckJSONPathInvalid(t *testing.T) {
+	tests := map[string]string{
+		"{.items[*}":                  "unclosed [",
+		"{.name":                      "unclosed {",
+		"{end}":                       "{end} without {range}",
+		"{range .[*]}{.name}":         "{range} without {end}",
+		"{[?(.status == 1)]}":         "must start with @",
+		`{[?(@.status == running)]}`:  "invalid literal running",
+		`{[?(@.status == "running)]}`: "unclosed {",
+		"{[1:2]}":                     "unsupported subscript [1:2]",
+		"{..}":                        "expected a field name after ..",
+		"{name}":                      `unexpected "name"`,
+	}
+	for template, want := range tests {
+		t.Run(template, func(t *testing.T) {
+			err := CheckJSONPath(template)
+
+			if err == nil || !strings.Contains(err.Error(), want) {
+				t.Errorf("expected error containing %q, got %v", want, err)
+			}
+		})
+	}
+}
+
+func TestFormatJSONPath(t *testing.T) {
+	tests := map[OutputFormat]string{
+		`jsonpath={range .[*]}{.id}: {.name}{"\n"}{end}`: "event-1: deploy, \"web\"\nevent-2: deploy, \"web\"\n",
+		`jsonpath={[?(@.id == "event-2")].created_at}`:   "2026-01-02T00:00:00Z",
+	}
+	for format, want := range tests {
+		t.Run(string(format), func(t *testing.T) {
+			got, err := Render(testEvents, format, Options{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != want {
+				t.Errorf("expected %q, got %q", want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,296 @@
+package render
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SortKey is a field of --sort-by, by field name or JSON key, dotted for
+// nested fields
+type SortKey struct {
+	Column     string
+	Descending bool
+}
+
+// SortKeys is the value of --sort-by (e.g., "status,-created_at"). It
+// implements pflag.Value: each use of the flag adds keys, an empty value
+// clears them.
+type SortKeys []SortKey
+
+func (k *SortKeys) String() string {
+	parts := make([]string, len(*k))
+	for i, key := range *k {
+		parts[i] = key.Column
+		if key.Descending {
+			parts[i] = "-" + key.Column
+		}
+	}
+	return strings.Join(parts, ",")
+}
+
+func (k *SortKeys) Set(value string) error {
+	if value == "" {
+		*k = nil
+		return nil
+	}
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		key := SortKey{Column: strings.TrimLeft(part, "+-"), Descending: strings.HasPrefix(part, "-")}
+		if key.Column == "" {
+			return fmt.Errorf("expected fields separated by commas, got %q", value)
+		}
+		*k = append(*k, key)
+	}
+	return nil
+}
+
+func (k *SortKeys) Type() string {
+	return "fields"
+}
+
+// filterOperators are the operators of --filter conditions, the two-character
+// ones first so they are matched before their prefix
+var filterOperators = []string{"!=", "!~", "<=", ">=", "=", "~", "<", ">"}
+
+// Condition is a condition of --filter on a field, by field name or JSON key,
+// dotted for nested fields
+type Condition struct {
+	Column   string
+	Operator string // One of filterOperators
+	Value    string
+}
+
+// Filters is the value of --filter (e.g., "status=running,region~osc"), the
+// conditions an item must all match to be shown. It implements pflag.Value:
+// each use of the flag adds conditions, an empty value clears them.
+type Filters []Condition
+
+func (f *Filters) String() string {
+	parts := make([]string, len(*f))
+	for i, c := range *f {
+		parts[i] = c.Column + c.Operator + c.Value
+	}
+	return strings.Join(parts, ",")
+}
+
+func (f *Filters) Set(value string) error {
+	if value == "" {
+		*f = nil
+		return nil
+	}
+	for _, part := range strings.Split(value, ",") {
+		c, err := parseCondition(strings.TrimSpace(part))
+		if err != nil {
+			return err
+		}
+		*f = append(*f, c)
+	}
+	return nil
+}
+
+func (f *Filters) Type() string {
+	return "conditions"
+}
+
+// parseCondition parses a condition such as "status=running", splitting it at
+// its first operator
+func parseCondition(s string) (Condition, error) {
+	at := strings.IndexAny(s, "!=~<>")
+	if at > 0 {
+		for _, op := range filterOperators {
+			if strings.HasPrefix(s[at:], op) {
+				return Condition{Column: strings.TrimSpace(s[:at]), Operator: op, Value: strings.TrimSpace(s[at+len(op):])}, nil
+			}
+		}
+	}
+	return Condition{}, fmt.Errorf("invalid condition %q, expected field, operator (%s) and value", s, strings.Join(filterOperators, " "))
+}
+
+// Arrange returns data with the items not matching opts.Filters left out and
+// the others sorted by opts.SortBy. Only slices of structs are arranged, and
+// the slices of Results. Arranging twice gives the same result.
+func Arrange(data any, opts Options) (any, error) {
+	if len(opts.SortBy) == 0 && len(opts.Filters) == 0 {
+		return data, nil
+	}
+	if results, ok := data.(Results); ok {
+		arranged := make(Results, len(results))
+		for i, r := range results {
+			value, err := Arrange(r.Value, opts)
+			if err != nil {
+				return nil, err
+			}
+			arranged[i] = NamedResult{Name: r.Name, Value: value}
+		}
+		return arranged, nil
+	}
+
+	v := reflect.ValueOf(data)
+	if v.Kind() != reflect.Slice || !nestedStruct(v.Type().Elem()) {
+		return data, nil
+	}
+	t := v.Type().Elem()
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	filters := make([]column, len(opts.Filters))
+	for i, c := range opts.Filters {
+		col, ok := resolveColumn(t, c.Column)
+		if !ok {
+			return nil, fmt.Errorf("--filter: unknown column %q, available columns: %s", c.Column, strings.Join(columnNames(t), ", "))
+		}
+		filters[i] = col
+	}
+	keys := make([]column, len(opts.SortBy))
+	for i, key := range opts.SortBy {
+		col, ok := resolveColumn(t, key.Column)
+		if !ok {
+			return nil, fmt.Errorf("--sort-by: unknown column %q, available columns: %s", key.Column, strings.Join(columnNames(t), ", "))
+		}
+		keys[i] = col
+	}
+
+	out := reflect.MakeSlice(v.Type(), 0, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		elem := v.Index(i)
+		if matchesFilters(elem, filters, opts.Filters) {
+			out = reflect.Append(out, elem)
+		}
+	}
+	sort.SliceStable(out.Interface(), func(i, j int) bool {
+		for k, col := range keys {
+			a, _ := col.value(out.Index(i))
+			b, _ := col.value(out.Index(j))
+			if c := compareFields(a, b); c != 0 {
+				return (c < 0) != opts.SortBy[k].Descending
+			}
+		}
+		return false
+	})
+	return out.Interface(), nil
+}
+
+// matchesFilters reports whether elem matches every condition, the field of
+// each being columns[i]
+func matchesFilters(elem reflect.Value, columns []column, conditions Filters) bool {
+	for i, c := range conditions {
+		field, _ := columns[i].value(elem)
+		if !c.matches(field) {
+			return false
+		}
+	}
+	return true
+}
+
+// matches reports whether a field matches the condition. Fields are compared
+// as formatted in tables, regardless of case, and ordered as numbers or times
+// when both sides are.
+func (c Condition) matches(field reflect.Value) bool {
+	got := inlineValue(field)
+	switch c.Operator {
+	case "=":
+		return strings.EqualFold(got, c.Value)
+	case "!=":
+		return !strings.EqualFold(got, c.Value)
+	case "~":
+		return strings.Contains(strings.ToLower(got), strings.ToLower(c.Value))
+	case "!~":
+		return !strings.Contains(strings.ToLower(got), strings.ToLower(c.Value))
+	}
+
+	order := compareText(got, c.Value)
+	switch c.Operator {
+	case "<":
+		return order < 0
+	case "<=":
+		return order <= 0
+	case ">":
+		return order > 0
+	default:
+		return order >= 0
+	}
+}
+
+// compareText compares a formatted field with a value, as numbers or times
+// when both parse as such
+func compareText(a, b string) int {
+	if x, err := strconv.ParseFloat(a, 64); err == nil {
+		if y, err := strconv.ParseFloat(b, 64); err == nil {
+			return compareNumbers(x, y)
+		}
+	}
+	if x, ok := parseTime(a); ok {
+		if y, ok := parseTime(b); ok {
+			return x.Compare(y)
+		}
+	}
+	return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+}
+
+// parseTime parses a time formatted with Rules.TimeLayout, or a date
+func parseTime(s string) (time.Time, bool) {
+	for _, layout := range []string{Rules.TimeLayout, time.RFC3339, time.DateOnly} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// compareFields orders two fields of the same type for --sort-by: nil
+// pointers first, then numbers, times, booleans and strings by value, other
+// values as formatted in tables
+func compareFields(a, b reflect.Value) int {
+	for a.Kind() == reflect.Ptr && b.Kind() == reflect.Ptr {
+		switch {
+		case a.IsNil() && b.IsNil():
+			return 0
+		case a.IsNil():
+			return -1
+		case b.IsNil():
+			return 1
+		}
+		a, b = a.Elem(), b.Elem()
+	}
+	if !a.IsValid() || !b.IsValid() {
+		return compareNumbers(boolNumber(a.IsValid()), boolNumber(b.IsValid()))
+	}
+
+	switch {
+	case a.Type() == timeType:
+		return a.Interface().(time.Time).Compare(b.Interface().(time.Time))
+	case a.CanInt():
+		return compareNumbers(float64(a.Int()), float64(b.Int()))
+	case a.CanUint():
+		return compareNumbers(float64(a.Uint()), float64(b.Uint()))
+	case a.CanFloat():
+		return compareNumbers(a.Float(), b.Float())
+	case a.Kind() == reflect.Bool:
+		return compareNumbers(boolNumber(a.Bool()), boolNumber(b.Bool()))
+	case a.Kind() == reflect.String:
+		return strings.Compare(strings.ToLower(a.String()), strings.ToLower(b.String()))
+	}
+	return strings.Compare(inlineValue(a), inlineValue(b))
+}
+
+func compareNumbers(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func boolNumber(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,151 @@
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// DefaultPager is the pager used when $PAGER isn't set: -R keeps the colors
+// and -S scrolls wide tables horizontally instead of wrapping them
+const DefaultPager = "less -RS"
+
+// ScrollWide leaves wide tables as wide as their content, for a pager to
+// scroll horizontally. Otherwise they are shrunk to the terminal width, the
+// widest columns being truncated with an ellipsis.
+var ScrollWide bool
+
+// Pager holds back the output of a command written to a terminal until it is
+// taller or wider than the terminal, then pipes it through a pager command.
+// Output that fits is written as is once the command ends, on Close.
+type Pager struct {
+	out           io.Writer
+	command       string   // Run by sh -c
+	env           []string // Environment of the pager
+	width, height int
+
+	buf     bytes.Buffer // Output held back
+	line    []byte       // Last line held back, not ended yet
+	lines   int
+	unpaged bool // Whether the output goes to out as is, see Unpaged
+	proc    *exec.Cmd
+	stdin   io.WriteCloser
+}
+
+// NewPager creates a pager of the output written to out, a terminal of the
+// given size. The pager command is $PAGER, DefaultPager when it isn't set.
+// NewPager returns nil when paging is disabled by an empty PAGER or PAGER=cat,
+// or when the pager isn't installed.
+func NewPager(out io.Writer, environ []string, width, height int) *Pager {
+	command, set := DefaultPager, false
+	var less bool
+	for _, kv := range environ {
+		if value, ok := strings.CutPrefix(kv, "PAGER="); ok {
+			command, set = strings.TrimSpace(value), true
+		}
+		less = less || strings.HasPrefix(kv, "LESS=")
+	}
+	if set && (command == "" || command == "cat") {
+		return nil
+	}
+	if _, err := exec.LookPath(strings.Fields(command)[0]); err != nil {
+		return nil
+	}
+
+	env := environ
+	if !less {
+		// Keeps colors and wide tables with PAGER=less too
+		env = append(env[:len(env):len(env)], "LESS=RS")
+	}
+	return &Pager{out: out, command: command, env: env, width: width, height: height}
+}
+
+// Write holds the output back until it doesn't fit in the terminal anymore,
+// then starts the pager with what was held back. Once the pager is quit, the
+// rest of the output is dropped.
+func (p *Pager) Write(b []byte) (int, error) {
+	switch {
+	case p.proc != nil:
+		p.stdin.Write(b)
+		return len(b), nil
+	case p.unpaged:
+		return p.out.Write(b)
+	}
+
+	p.buf.Write(b)
+	p.line = append(p.line, b...)
+	wide := false
+	for {
+		i := bytes.IndexByte(p.line, '\n')
+		if i < 0 {
+			break
+		}
+		p.lines++
+		wide = wide || ansi.StringWidth(string(p.line[:i])) > p.width
+		p.line = append(p.line[:0], p.line[i+1:]...)
+	}
+	// The last line of the terminal is left for the prompt
+	if wide || p.lines >= p.height {
+		return len(b), p.start()
+	}
+	return len(b), nil
+}
+
+// start runs the pager on the output held back, writing it to the terminal
+// instead if the pager can't be started
+func (p *Pager) start() error {
+	proc := exec.Command("sh", "-c", p.command)
+	proc.Env = p.env
+	proc.Stdout, proc.Stderr = p.out, os.Stderr
+	stdin, err := proc.StdinPipe()
+	if err == nil {
+		err = proc.Start()
+	}
+	if err != nil {
+		p.unpaged = true
+		_, err := p.out.Write(p.buf.Bytes())
+		p.buf.Reset()
+		return err
+	}
+
+	p.proc, p.stdin = proc, stdin
+	p.stdin.Write(p.buf.Bytes())
+	p.buf.Reset()
+	return nil
+}
+
+// Close writes the output held back, or waits for the pager to be quit
+func (p *Pager) Close() error {
+	if p.proc == nil {
+		_, err := p.out.Write(p.buf.Bytes())
+		p.buf.Reset()
+		return err
+	}
+	p.stdin.Close()
+	if err := p.proc.Wait(); err != nil {
+		return fmt.Errorf("pager %q failed: %w", p.command, err)
+	}
+	return nil
+}
+
+// Unpaged returns the writer for output written as it arrives or taking over
+// the terminal, such as streams and the table browser: the terminal of a
+// Pager, after the output it held back, unless its pager already started. w
+// is returned as is when it isn't a Pager.
+func Unpaged(w io.Writer) io.Writer {
+	p, ok := w.(*Pager)
+	if !ok || p.proc != nil {
+		return w
+	}
+	if !p.unpaged {
+		p.unpaged = true
+		p.out.Write(p.buf.Bytes())
+		p.buf.Reset()
+	}
+	return p.out
+}
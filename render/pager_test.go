@@ -0,0 +1,134 @@
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// testPager prefixes the lines it pages
+const testPager = "PAGER=sed s/^/paged:/"
+
+// writeLines writes n numbered lines to w
+func writeLines(w *Pager, n int) {
+	for i := 1; i <= n; i++ {
+		fmt.Fprintf(w, "line %d\n", i)
+	}
+}
+
+func TestPager(t *testing.T) {
+	tests := map[string]struct {
+		lines int
+		line  string
+		paged bool
+	}{
+		"fitting":       {lines: 5},
+		"tall":          {lines: 10, paged: true},
+		"wide":          {lines: 1, line: strings.Repeat("x", 41), paged: true},
+		"terminal wide": {lines: 0, line: strings.Repeat("x", 40)},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var out bytes.Buffer
+			pager := NewPager(&out, []string{testPager}, 40, 10)
+
+			writeLines(pager, tt.lines)
+			fmt.Fprintln(pager, tt.line)
+			if err := pager.Close(); err != nil {
+				t.Fatal(err)
+			}
+
+			if paged := strings.HasPrefix(out.String(), "paged:"); paged != tt.paged {
+				t.Errorf("expected paged %v, got %q", tt.paged, out.String())
+			}
+			if want := tt.lines + 1; strings.Count(out.String(), "\n") != want {
+				t.Errorf("expected %d lines, got %q", want, out.String())
+			}
+		})
+	}
+}
+
+func TestPagerUnpaged(t *testing.T) {
+	var out bytes.Buffer
+	pager := NewPager(&out, []string{testPager}, 40, 10)
+
+	writeLines(pager, 2)
+	if out.Len() != 0 {
+		t.Fatalf("expected the output held back, got %q", out.String())
+	}
+	if w := Unpaged(pager); w != &out {
+		t.Errorf("expected the terminal, got %T", w)
+	}
+	writeLines(pager, 20)
+	if err := pager.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Contains(out.String(), "paged:") || strings.Count(out.String(), "\n") != 22 {
+		t.Errorf("expected 22 lines unpaged, got %q", out.String())
+	}
+}
+
+func TestPagerLessOptions(t *testing.T) {
+	tests := map[string][]string{
+		"RS": {`PAGER=printf %s "$LESS"`},
+		"X":  {`PAGER=printf %s "$LESS"`, "LESS=X"},
+	}
+	for want, environ := range tests {
+		t.Run(want, func(t *testing.T) {
+			var out bytes.Buffer
+			pager := NewPager(&out, environ, 40, 10)
+
+			writeLines(pager, 10)
+			if err := pager.Close(); err != nil {
+				t.Fatal(err)
+			}
+
+			if out.String() != want {
+				t.Errorf("expected %q, got %q", want, out.String())
+			}
+		})
+	}
+}
+
+func TestPagerDisabled(t *testing.T) {
+	for _, environ := range []string{"PAGER=", "PAGER=cat", "PAGER=no-such-pager -R"} {
+		if pager := NewPager(&bytes.Buffer{}, []string{environ}, 40, 10); pager != nil {
+			t.Errorf("expected no pager with %s", environ)
+		}
+	}
+}
+
+func TestWideTable(t *testing.T) {
+	tests := map[string]struct {
+		scroll    bool
+		maxWidth  int
+		truncated bool
+	}{
+		"truncated": {maxWidth: 120, truncated: true},
+		"scrolled":  {scroll: true, maxWidth: 250},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			prev := ScrollWide
+			ScrollWide = tt.scroll
+			t.Cleanup(func() { ScrollWide = prev })
+
+			out, err := Render([]testApp{{Name: strings.Repeat("long-name-", 20)}}, FormatTable, Options{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			width := lipgloss.Width(out)
+			if width > tt.maxWidth || tt.scroll && width <= 200 {
+				t.Errorf("expected a table at most %d wide, got %d:\n%s", tt.maxWidth, width, out)
+			}
+			if truncated := strings.Contains(out, "…"); truncated != tt.truncated {
+				t.Errorf("expected truncated %v, got:\n%s", tt.truncated, out)
+			}
+		})
+	}
+}
@@ -0,0 +1,310 @@
+package render
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// StreamsPages reports whether pages rendered in format are written as they
+// are fetched, instead of once every page was fetched
+func StreamsPages(format OutputFormat) bool {
+	switch format {
+	case FormatTable, FormatNDJSON, FormatCSV, FormatTSV, FormatMarkdown, FormatRaw:
+		return true
+	}
+	return false
+}
+
+// PageWriter writes the pages of a paginated result as they are fetched. Tables
+// keep the column widths of the first page so the rows line up across pages,
+// and the row formats only write the header of the first page.
+type PageWriter struct {
+	w      io.Writer
+	format OutputFormat
+	opts   Options
+	table  *streamTable
+	header bool // Whether the header of a row format was written
+	shown  int
+}
+
+// NewPageWriter creates a page writer rendering pages in format, one of the
+// formats StreamsPages accepts, showing the columns selected by opts
+func NewPageWriter(w io.Writer, format OutputFormat, opts Options) *PageWriter {
+	return &PageWriter{w: w, format: format, opts: opts}
+}
+
+// Write renders a page, a slice of results, filtered by the options of the
+// page writer
+func (pw *PageWriter) Write(page any) error {
+	page, err := Arrange(page, pw.opts)
+	if err != nil {
+		return err
+	}
+	v := reflect.ValueOf(page)
+	if v.Kind() != reflect.Slice {
+		return fmt.Errorf("expected slice page, got %s", v.Kind())
+	}
+	pw.shown += v.Len()
+
+	switch pw.format {
+	case FormatNDJSON:
+		_, err := io.WriteString(pw.w, renderNDJSON(v))
+		return err
+	case FormatCSV, FormatTSV, FormatMarkdown, FormatRaw:
+		return pw.writeRecords(v)
+	}
+
+	tr, err := NewTableRenderer(page, pw.opts)
+	if err != nil {
+		return err
+	}
+	if len(tr.columns) == 0 {
+		return nil
+	}
+	if pw.table == nil {
+		pw.table = newStreamTable(tr.columns, tr.rows, tableWidth())
+		_, err = io.WriteString(pw.w, pw.table.header())
+		if err != nil {
+			return err
+		}
+	}
+	_, err = io.WriteString(pw.w, pw.table.rows(tr.rows))
+	return err
+}
+
+// writeRecords writes the rows of a page in a row format, after the header if
+// it is the first page with rows
+func (pw *PageWriter) writeRecords(page reflect.Value) error {
+	cells := dataCells
+	if pw.format == FormatMarkdown {
+		cells = markdownCells
+	}
+	recs, err := extractRecords(page, pw.opts, cells)
+	// A page whose items were all filtered out writes nothing, not even a
+	// blank line after the header
+	if err != nil || len(recs.columns) == 0 || len(recs.rows) == 0 {
+		return err
+	}
+
+	rows := recs.rows
+	var out string
+	switch pw.format {
+	case FormatMarkdown:
+		if !pw.header {
+			rows = append([][]string{recs.headers(), markdownRule(len(recs.columns))}, rows...)
+		}
+		out = markdownRows(rows)
+	case FormatRaw:
+		out = rawRows(rows)
+	default:
+		if !pw.header {
+			rows = append([][]string{recs.keys()}, rows...)
+		}
+		comma := ','
+		if pw.format == FormatTSV {
+			comma = '\t'
+		}
+		if out, err = writeDelimited(rows, comma); err != nil {
+			return err
+		}
+	}
+	pw.header = true
+	_, err = io.WriteString(pw.w, out+"\n")
+	return err
+}
+
+// Close ends the table and writes the footer describing the pages fetched, meta
+// being the PaginationMeta of the last page fetched. Other formats have no
+// footer.
+func (pw *PageWriter) Close(meta any, page int) error {
+	if pw.format != FormatTable {
+		return nil
+	}
+	var out string
+	if pw.table == nil {
+		out = SubtitleStyle.Render("No data to display") + "\n"
+	} else {
+		out = pw.table.footer()
+	}
+	out += RenderPageFooter(pw.format, meta, page, pw.shown) + "\n"
+	_, err := io.WriteString(pw.w, out)
+	return err
+}
+
+// RenderPageFooter describes the pages fetched by a paginated command: the last
+// page fetched, the number of items shown and how to get the next page. meta is
+// the PaginationMeta of the last page, its fields being read by name as the
+// SDK doesn't always fill them. It is empty for formats read by programs.
+func RenderPageFooter(format OutputFormat, meta any, page, shown int) string {
+	if format != FormatTable {
+		return ""
+	}
+
+	totalPages := metaField(meta, "TotalPages")
+	totalCount := metaField(meta, "TotalCount")
+	nextPage := metaField(meta, "NextPage")
+
+	parts := []string{fmt.Sprintf("Page %d", page)}
+	if totalPages > 0 {
+		parts[0] += fmt.Sprintf(" of %d", totalPages)
+	}
+	if totalCount > 0 {
+		parts = append(parts, fmt.Sprintf("%d of %d items", shown, totalCount))
+	} else if shown == 1 {
+		parts = append(parts, "1 item")
+	} else {
+		parts = append(parts, fmt.Sprintf("%d items", shown))
+	}
+	if nextPage > 0 {
+		parts = append(parts, fmt.Sprintf("more with --page %d or --all", nextPage))
+	}
+	return SubtitleStyle.Render(strings.Join(parts, " · "))
+}
+
+// metaField returns the int field of a PaginationMeta, 0 if it has none
+func metaField(meta any, name string) int {
+	v := reflect.ValueOf(meta)
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return 0
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return 0
+	}
+	f := v.FieldByName(name)
+	if !f.IsValid() || !f.CanInt() {
+		return 0
+	}
+	return int(f.Int())
+}
+
+// renderNDJSON renders each element of a slice as a JSON line
+func renderNDJSON(v reflect.Value) string {
+	var sb strings.Builder
+	for i := 0; i < v.Len(); i++ {
+		b, err := json.Marshal(v.Index(i).Interface())
+		if err != nil {
+			b, _ = json.Marshal(err.Error())
+		}
+		sb.Write(b)
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+// streamTable renders a table row by row with fixed column widths, in the style
+// of TableRenderer
+type streamTable struct {
+	columns []string
+	widths  []int // Content width of each column, without padding
+	border  lipgloss.Border
+	plain   bool // Whether the table has no borders, see Plain
+	written int
+}
+
+// newStreamTable sizes the columns to fit the first rows, shrinking the widest
+// ones until the table fits in width. Plain tables are never shrunk.
+func newStreamTable(columns []string, rows [][]string, width int) *streamTable {
+	widths := make([]int, len(columns))
+	for i, col := range columns {
+		widths[i] = lipgloss.Width(col)
+		for _, row := range rows {
+			widths[i] = max(widths[i], lipgloss.Width(row[i]))
+		}
+	}
+
+	// Each column has 1 space of padding on both sides and a border on its right
+	total := func() int {
+		sum := 1
+		for _, w := range widths {
+			sum += w + 3
+		}
+		return sum
+	}
+	for !Plain && total() > width {
+		widest := 0
+		for i, w := range widths {
+			if w > widths[widest] {
+				widest = i
+			}
+		}
+		if widths[widest] <= 3 {
+			break
+		}
+		widths[widest]--
+	}
+
+	return &streamTable{columns: columns, widths: widths, border: lipgloss.RoundedBorder(), plain: Plain}
+}
+
+// line renders a horizontal border line, none for plain tables
+func (st *streamTable) line(left, middle, right string) string {
+	if st.plain {
+		return ""
+	}
+	segments := make([]string, len(st.widths))
+	for i, w := range st.widths {
+		segments[i] = strings.Repeat(st.border.Top, w+2)
+	}
+	style := lipgloss.NewStyle().Foreground(borderColor)
+	return style.Render(left+strings.Join(segments, middle)+right) + "\n"
+}
+
+// row renders cells with the given style, truncated to the column widths
+func (st *streamTable) row(cells []string, style lipgloss.Style) string {
+	sep := lipgloss.NewStyle().Foreground(borderColor).Render(st.border.Left)
+	if st.plain {
+		// Columns are separated by two spaces instead of padding and borders
+		sep = ""
+		style = style.PaddingLeft(0).PaddingRight(2)
+	}
+	var sb strings.Builder
+	sb.WriteString(sep)
+	for i, w := range st.widths {
+		cell := ansi.Truncate(cells[i], w, "…")
+		sb.WriteString(style.Width(w + 2).Render(cell))
+		sb.WriteString(sep)
+	}
+	if st.plain {
+		return trimLines(sb.String()) + "\n"
+	}
+	sb.WriteString("\n")
+	return sb.String()
+}
+
+// header renders the top border, the column names and the header separator
+func (st *streamTable) header() string {
+	b := st.border
+	headerStyle := lipgloss.NewStyle().Bold(true).Foreground(primaryColor).Padding(0, 1)
+	return st.line(b.TopLeft, b.MiddleTop, b.TopRight) +
+		st.row(st.columns, headerStyle) +
+		st.line(b.MiddleLeft, b.Middle, b.MiddleRight)
+}
+
+// rows renders rows, alternating their colors across pages
+func (st *streamTable) rows(rows [][]string) string {
+	var sb strings.Builder
+	for _, row := range rows {
+		color := rowColor
+		if st.written%2 == 0 {
+			color = altRowColor
+		}
+		sb.WriteString(st.row(row, lipgloss.NewStyle().Foreground(color).Padding(0, 1)))
+		st.written++
+	}
+	return sb.String()
+}
+
+// footer renders the bottom border
+func (st *streamTable) footer() string {
+	b := st.border
+	return st.line(b.BottomLeft, b.MiddleBottom, b.BottomRight)
+}
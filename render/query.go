@@ -0,0 +1,809 @@
+package render
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// The query language of --query is a subset of jq applied to the JSON form of
+// a result:
+//
+//	.name .owner.username .["last-deployed-at"]   fields
+//	.[0] .[-1] .[]                                 indexes and iteration
+//	a | b                                          pipes
+//	== != < <= > >= and or                         comparisons
+//	[...] {name, owner: .owner.username}           arrays and objects
+//	select(f) map(f) sort_by(f) length keys not first last
+//
+// Each value the query produces is printed on its own line, strings as is.
+
+// queryFunc evaluates a query against its input, producing any number of values
+type queryFunc func(input any) ([]any, error)
+
+// CheckQuery reports syntax errors in a --query expression
+func CheckQuery(query string) error {
+	if query == "" {
+		return nil
+	}
+	_, err := parseQuery(query)
+	return err
+}
+
+// renderQuery evaluates query against the JSON form of data. Values are
+// rendered one per line, strings as is and others as JSON, compact with
+// ndjson.
+func renderQuery(data any, query string, format OutputFormat) (string, error) {
+	q, err := parseQuery(query)
+	if err != nil {
+		return "", err
+	}
+	input, err := jsonValue(data)
+	if err != nil {
+		return "", err
+	}
+	values, err := q(input)
+	if err != nil {
+		return "", fmt.Errorf("query %q: %w", query, err)
+	}
+
+	lines := make([]string, len(values))
+	for i, v := range values {
+		if lines[i], err = queryValueString(v, format != FormatNDJSON); err != nil {
+			return "", err
+		}
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+// queryValueString formats a value produced by a query or a JSONPath: strings
+// as is, other values as JSON
+func queryValueString(v any, indent bool) (string, error) {
+	if s, ok := v.(string); ok {
+		return s, nil
+	}
+	var b []byte
+	var err error
+	if indent {
+		b, err = json.MarshalIndent(v, "", "  ")
+	} else {
+		b, err = json.Marshal(v)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal to JSON: %w", err)
+	}
+	return string(b), nil
+}
+
+// jsonValue returns the JSON form of data, decoded to maps, slices, strings,
+// json.Numbers, bools and nils. Response bodies are decoded when they are
+// JSON, and kept as strings otherwise.
+func jsonValue(data any) (any, error) {
+	var b []byte
+	if resp, ok := data.(*http.Response); ok {
+		if resp.Body != nil {
+			defer resp.Body.Close()
+			body, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read response body: %w", err)
+			}
+			if !json.Valid(body) {
+				return string(body), nil
+			}
+			b = body
+		}
+	} else {
+		var err error
+		if b, err = json.Marshal(data); err != nil {
+			return nil, fmt.Errorf("failed to marshal to JSON: %w", err)
+		}
+	}
+	if len(b) == 0 {
+		return nil, nil
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, fmt.Errorf("failed to decode JSON: %w", err)
+	}
+	return v, nil
+}
+
+// queryParser parses a query into a queryFunc
+type queryParser struct {
+	tokens []string
+	pos    int
+}
+
+func parseQuery(query string) (queryFunc, error) {
+	tokens, err := lexQuery(query)
+	if err != nil {
+		return nil, fmt.Errorf("invalid query %q: %w", query, err)
+	}
+	p := &queryParser{tokens: tokens}
+	q, err := p.parsePipe()
+	if err == nil && p.pos < len(p.tokens) {
+		err = fmt.Errorf("unexpected %q", p.tokens[p.pos])
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid query %q: %w", query, err)
+	}
+	return q, nil
+}
+
+// lexQuery splits a query into tokens: punctuation, operators, identifiers,
+// quoted strings and numbers
+func lexQuery(query string) ([]string, error) {
+	var tokens []string
+	for i := 0; i < len(query); {
+		c := query[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n':
+			i++
+		case c == '"':
+			end := i + 1
+			for end < len(query) && query[end] != '"' {
+				if query[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(query) {
+				return nil, fmt.Errorf("unterminated string")
+			}
+			tokens = append(tokens, query[i:end+1])
+			i = end + 1
+		case strings.HasPrefix(query[i:], "==") || strings.HasPrefix(query[i:], "!=") ||
+			strings.HasPrefix(query[i:], "<=") || strings.HasPrefix(query[i:], ">="):
+			tokens = append(tokens, query[i:i+2])
+			i += 2
+		case strings.ContainsRune(".[](){}|,:<>", rune(c)):
+			tokens = append(tokens, string(c))
+			i++
+		case c == '-' || c >= '0' && c <= '9':
+			end := i + 1
+			for end < len(query) && (query[end] >= '0' && query[end] <= '9' || query[end] == '.' || query[end] == 'e' || query[end] == 'E') {
+				end++
+			}
+			if _, err := strconv.ParseFloat(query[i:end], 64); err != nil {
+				return nil, fmt.Errorf("invalid number %q", query[i:end])
+			}
+			tokens = append(tokens, query[i:end])
+			i = end
+		case c == '_' || unicode.IsLetter(rune(c)):
+			end := i + 1
+			for end < len(query) && (query[end] == '_' || unicode.IsLetter(rune(query[end])) || unicode.IsDigit(rune(query[end]))) {
+				end++
+			}
+			tokens = append(tokens, query[i:end])
+			i = end
+		default:
+			return nil, fmt.Errorf("unexpected %q", c)
+		}
+	}
+	return tokens, nil
+}
+
+func (p *queryParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *queryParser) next() string {
+	tok := p.peek()
+	p.pos++
+	return tok
+}
+
+func (p *queryParser) expect(tok string) error {
+	if got := p.next(); got != tok {
+		if got == "" {
+			return fmt.Errorf("expected %q at the end", tok)
+		}
+		return fmt.Errorf("expected %q, got %q", tok, got)
+	}
+	return nil
+}
+
+// parsePipe parses a | b | ...
+func (p *queryParser) parsePipe() (queryFunc, error) {
+	left, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek() == "|" {
+		p.next()
+		right, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		left = pipe(left, right)
+	}
+	return left, nil
+}
+
+func pipe(left, right queryFunc) queryFunc {
+	return func(input any) ([]any, error) {
+		values, err := left(input)
+		if err != nil {
+			return nil, err
+		}
+		var out []any
+		for _, v := range values {
+			results, err := right(v)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, results...)
+		}
+		return out, nil
+	}
+}
+
+// parseOr parses a or b, and a and b
+func (p *queryParser) parseOr() (queryFunc, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek() == "or" {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = binary(left, right, func(a, b any) (any, error) { return truthy(a) || truthy(b), nil })
+	}
+	return left, nil
+}
+
+func (p *queryParser) parseAnd() (queryFunc, error) {
+	left, err := p.parseComparison()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek() == "and" {
+		p.next()
+		right, err := p.parseComparison()
+		if err != nil {
+			return nil, err
+		}
+		left = binary(left, right, func(a, b any) (any, error) { return truthy(a) && truthy(b), nil })
+	}
+	return left, nil
+}
+
+// parseComparison parses a == b and the other comparisons
+func (p *queryParser) parseComparison() (queryFunc, error) {
+	left, err := p.parsePostfix()
+	if err != nil {
+		return nil, err
+	}
+	op := p.peek()
+	switch op {
+	case "==", "!=", "<", "<=", ">", ">=":
+	default:
+		return left, nil
+	}
+	p.next()
+	right, err := p.parsePostfix()
+	if err != nil {
+		return nil, err
+	}
+	return binary(left, right, func(a, b any) (any, error) { return compareOp(op, a, b) }), nil
+}
+
+// binary applies op to every pair of values produced by left and right
+func binary(left, right queryFunc, op func(a, b any) (any, error)) queryFunc {
+	return func(input any) ([]any, error) {
+		as, err := left(input)
+		if err != nil {
+			return nil, err
+		}
+		bs, err := right(input)
+		if err != nil {
+			return nil, err
+		}
+		var out []any
+		for _, a := range as {
+			for _, b := range bs {
+				v, err := op(a, b)
+				if err != nil {
+					return nil, err
+				}
+				out = append(out, v)
+			}
+		}
+		return out, nil
+	}
+}
+
+// parsePostfix parses a term followed by fields, indexes and iterations
+func (p *queryParser) parsePostfix() (queryFunc, error) {
+	term, err := p.parseTerm()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		switch p.peek() {
+		case ".":
+			p.next()
+			step, err := p.parseField()
+			if err != nil {
+				return nil, err
+			}
+			term = pipe(term, step)
+		case "[":
+			step, err := p.parseBrackets()
+			if err != nil {
+				return nil, err
+			}
+			term = pipe(term, step)
+		default:
+			return term, nil
+		}
+	}
+}
+
+// parseField parses the name following a dot
+func (p *queryParser) parseField() (queryFunc, error) {
+	tok := p.peek()
+	switch {
+	case strings.HasPrefix(tok, `"`):
+		p.next()
+		name, err := strconv.Unquote(tok)
+		if err != nil {
+			return nil, fmt.Errorf("invalid string %s", tok)
+		}
+		return fieldStep(name), nil
+	case isIdent(tok):
+		p.next()
+		return fieldStep(tok), nil
+	case tok == "[":
+		return p.parseBrackets()
+	}
+	return nil, fmt.Errorf("expected a field name after \".\", got %q", tok)
+}
+
+// parseBrackets parses [], [n] and ["name"]
+func (p *queryParser) parseBrackets() (queryFunc, error) {
+	p.next()
+	if p.peek() == "]" {
+		p.next()
+		return iterate, nil
+	}
+	tok := p.next()
+	if err := p.expect("]"); err != nil {
+		return nil, err
+	}
+	if strings.HasPrefix(tok, `"`) {
+		name, err := strconv.Unquote(tok)
+		if err != nil {
+			return nil, fmt.Errorf("invalid string %s", tok)
+		}
+		return fieldStep(name), nil
+	}
+	n, err := strconv.Atoi(tok)
+	if err != nil {
+		return nil, fmt.Errorf("invalid index %q", tok)
+	}
+	return indexStep(n), nil
+}
+
+// parseTerm parses ., literals, parentheses, arrays, objects and functions
+func (p *queryParser) parseTerm() (queryFunc, error) {
+	tok := p.next()
+	switch {
+	case tok == ".":
+		switch next := p.peek(); {
+		case isIdent(next) || strings.HasPrefix(next, `"`):
+			return p.parseField()
+		}
+		return identity, nil
+	case tok == "(":
+		q, err := p.parsePipe()
+		if err != nil {
+			return nil, err
+		}
+		return q, p.expect(")")
+	case tok == "[":
+		if p.peek() == "]" {
+			p.next()
+			return constant([]any{}), nil
+		}
+		q, err := p.parsePipe()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect("]"); err != nil {
+			return nil, err
+		}
+		return func(input any) ([]any, error) {
+			values, err := q(input)
+			if values == nil {
+				values = []any{}
+			}
+			return []any{values}, err
+		}, nil
+	case tok == "{":
+		return p.parseObject()
+	case strings.HasPrefix(tok, `"`):
+		s, err := strconv.Unquote(tok)
+		if err != nil {
+			return nil, fmt.Errorf("invalid string %s", tok)
+		}
+		return constant(s), nil
+	case tok != "" && (tok[0] == '-' || tok[0] >= '0' && tok[0] <= '9'):
+		return constant(json.Number(tok)), nil
+	case tok == "true" || tok == "false":
+		return constant(tok == "true"), nil
+	case tok == "null":
+		return constant(nil), nil
+	case isIdent(tok):
+		return p.parseFunction(tok)
+	case tok == "":
+		return nil, fmt.Errorf("unexpected end")
+	}
+	return nil, fmt.Errorf("unexpected %q", tok)
+}
+
+// parseObject parses {key: value, name, ...} after its opening brace
+func (p *queryParser) parseObject() (queryFunc, error) {
+	type entry struct {
+		key   string
+		value queryFunc
+	}
+	var entries []entry
+	for p.peek() != "}" {
+		tok := p.next()
+		key := tok
+		if strings.HasPrefix(tok, `"`) {
+			var err error
+			if key, err = strconv.Unquote(tok); err != nil {
+				return nil, fmt.Errorf("invalid string %s", tok)
+			}
+		} else if !isIdent(tok) {
+			return nil, fmt.Errorf("expected an object key, got %q", tok)
+		}
+		value := fieldStep(key)
+		if p.peek() == ":" {
+			p.next()
+			var err error
+			if value, err = p.parseOr(); err != nil {
+				return nil, err
+			}
+		}
+		entries = append(entries, entry{key, value})
+		if p.peek() != "," {
+			break
+		}
+		p.next()
+	}
+	if err := p.expect("}"); err != nil {
+		return nil, err
+	}
+
+	return func(input any) ([]any, error) {
+		objects := []map[string]any{{}}
+		for _, e := range entries {
+			values, err := e.value(input)
+			if err != nil {
+				return nil, err
+			}
+			var next []map[string]any
+			for _, obj := range objects {
+				for _, v := range values {
+					o := make(map[string]any, len(obj)+1)
+					for k, x := range obj {
+						o[k] = x
+					}
+					o[e.key] = v
+					next = append(next, o)
+				}
+			}
+			objects = next
+		}
+		out := make([]any, len(objects))
+		for i, obj := range objects {
+			out[i] = obj
+		}
+		return out, nil
+	}, nil
+}
+
+// parseFunction parses a call to a built-in function
+func (p *queryParser) parseFunction(name string) (queryFunc, error) {
+	switch name {
+	case "length":
+		return queryLength, nil
+	case "keys":
+		return queryKeys, nil
+	case "not":
+		return func(input any) ([]any, error) { return []any{!truthy(input)}, nil }, nil
+	case "first":
+		return indexStep(0), nil
+	case "last":
+		return indexStep(-1), nil
+	case "select", "map", "sort_by":
+	default:
+		return nil, fmt.Errorf("unknown function %q", name)
+	}
+
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	arg, err := p.parsePipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect(")"); err != nil {
+		return nil, err
+	}
+	switch name {
+	case "select":
+		return func(input any) ([]any, error) {
+			values, err := arg(input)
+			if err != nil {
+				return nil, err
+			}
+			for _, v := range values {
+				if truthy(v) {
+					return []any{input}, nil
+				}
+			}
+			return nil, nil
+		}, nil
+	case "map":
+		return pipe(func(input any) ([]any, error) {
+			items, err := iterate(input)
+			return []any{items}, err
+		}, func(input any) ([]any, error) {
+			out := []any{}
+			for _, item := range input.([]any) {
+				values, err := arg(item)
+				if err != nil {
+					return nil, err
+				}
+				out = append(out, values...)
+			}
+			return []any{out}, nil
+		}), nil
+	default:
+		return func(input any) ([]any, error) {
+			items, ok := input.([]any)
+			if !ok {
+				return nil, fmt.Errorf("cannot sort %s", jsonType(input))
+			}
+			keys := make([]any, len(items))
+			for i, item := range items {
+				values, err := arg(item)
+				if err != nil {
+					return nil, err
+				}
+				if len(values) > 0 {
+					keys[i] = values[0]
+				}
+			}
+			order := make([]int, len(items))
+			for i := range order {
+				order[i] = i
+			}
+			var sortErr error
+			sort.SliceStable(order, func(i, j int) bool {
+				c, err := compareValues(keys[order[i]], keys[order[j]])
+				if err != nil {
+					sortErr = err
+				}
+				return c < 0
+			})
+			sorted := make([]any, len(items))
+			for i, o := range order {
+				sorted[i] = items[o]
+			}
+			return []any{sorted}, sortErr
+		}, nil
+	}
+}
+
+func isIdent(tok string) bool {
+	return tok != "" && (tok[0] == '_' || unicode.IsLetter(rune(tok[0])))
+}
+
+func identity(input any) ([]any, error) {
+	return []any{input}, nil
+}
+
+func constant(v any) queryFunc {
+	return func(any) ([]any, error) { return []any{v}, nil }
+}
+
+// fieldStep returns the field name of objects, null for null
+func fieldStep(name string) queryFunc {
+	return func(input any) ([]any, error) {
+		switch v := input.(type) {
+		case nil:
+			return []any{nil}, nil
+		case map[string]any:
+			return []any{v[name]}, nil
+		}
+		return nil, fmt.Errorf("cannot get field %q of %s", name, jsonType(input))
+	}
+}
+
+// indexStep returns the nth item of arrays, counting from the end when n is
+// negative, null when out of range
+func indexStep(n int) queryFunc {
+	return func(input any) ([]any, error) {
+		switch v := input.(type) {
+		case nil:
+			return []any{nil}, nil
+		case []any:
+			i := n
+			if i < 0 {
+				i += len(v)
+			}
+			if i < 0 || i >= len(v) {
+				return []any{nil}, nil
+			}
+			return []any{v[i]}, nil
+		}
+		return nil, fmt.Errorf("cannot index %s with a number", jsonType(input))
+	}
+}
+
+// iterate produces the items of arrays and the values of objects, by key
+func iterate(input any) ([]any, error) {
+	switch v := input.(type) {
+	case []any:
+		return v, nil
+	case map[string]any:
+		keys := sortedKeys(v)
+		out := make([]any, len(keys))
+		for i, k := range keys {
+			out[i] = v[k]
+		}
+		return out, nil
+	}
+	return nil, fmt.Errorf("cannot iterate over %s", jsonType(input))
+}
+
+func queryLength(input any) ([]any, error) {
+	var n int
+	switch v := input.(type) {
+	case nil:
+	case string:
+		n = len([]rune(v))
+	case []any:
+		n = len(v)
+	case map[string]any:
+		n = len(v)
+	default:
+		return nil, fmt.Errorf("%s has no length", jsonType(input))
+	}
+	return []any{json.Number(strconv.Itoa(n))}, nil
+}
+
+func queryKeys(input any) ([]any, error) {
+	v, ok := input.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%s has no keys", jsonType(input))
+	}
+	keys := sortedKeys(v)
+	out := make([]any, len(keys))
+	for i, k := range keys {
+		out[i] = k
+	}
+	return []any{out}, nil
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// truthy reports whether a value is true in conditions: all but false and null
+func truthy(v any) bool {
+	return v != nil && v != false
+}
+
+// compareOp applies a comparison operator
+func compareOp(op string, a, b any) (bool, error) {
+	if op == "==" || op == "!=" {
+		equal := reflect.DeepEqual(a, b)
+		if x, ok := toNumber(a); ok {
+			if y, ok := toNumber(b); ok {
+				equal = x == y
+			}
+		}
+		return equal == (op == "=="), nil
+	}
+	c, err := compareValues(a, b)
+	if err != nil {
+		return false, err
+	}
+	switch op {
+	case "<":
+		return c < 0, nil
+	case "<=":
+		return c <= 0, nil
+	case ">":
+		return c > 0, nil
+	default:
+		return c >= 0, nil
+	}
+}
+
+// compareValues orders two numbers or two strings, nulls coming first
+func compareValues(a, b any) (int, error) {
+	switch {
+	case a == nil && b == nil:
+		return 0, nil
+	case a == nil:
+		return -1, nil
+	case b == nil:
+		return 1, nil
+	}
+	if x, ok := toNumber(a); ok {
+		if y, ok := toNumber(b); ok {
+			switch {
+			case x < y:
+				return -1, nil
+			case x > y:
+				return 1, nil
+			}
+			return 0, nil
+		}
+	}
+	if x, ok := a.(string); ok {
+		if y, ok := b.(string); ok {
+			return strings.Compare(x, y), nil
+		}
+	}
+	return 0, fmt.Errorf("cannot compare %s and %s", jsonType(a), jsonType(b))
+}
+
+// toNumber converts JSON numbers to float64
+func toNumber(v any) (float64, bool) {
+	switch n := v.(type) {
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	case float64:
+		return n, true
+	}
+	return 0, false
+}
+
+// jsonType names the JSON type of a decoded value, for errors
+func jsonType(v any) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "a boolean"
+	case json.Number, float64:
+		return "a number"
+	case string:
+		return "a string"
+	case []any:
+		return "an array"
+	case map[string]any:
+		return "an object"
+	}
+	return fmt.Sprintf("%T", v)
+}
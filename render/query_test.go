@@ -0,0 +1,104 @@
+package render
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderQuery(t *testing.T) {
+	items := []map[string]any{
+		{"name": "web", "status": "running", "tags": []string{"a", "b"}},
+		{"name": "api", "status": "stopped", "tags": []string{"c"}},
+	}
+	tests := map[string]string{
+		".[0].name":   "web",
+		".[-1].name":  "api",
+		".[] | .name": "web\napi",
+		`.[] | select(.status == "running") | .name`: "web",
+		"map(.name) | length":                        "2",
+		"[.[] | .tags | last]":                       `["b","c"]`,
+		"sort_by(.name) | first | .name":             "api",
+		".[] | {name, count: (.tags | length)}":      "{\"count\":2,\"name\":\"web\"}\n{\"count\":1,\"name\":\"api\"}",
+	}
+	for query, want := range tests {
+		t.Run(query, func(t *testing.T) {
+			got, err := renderQuery(items, query, FormatNDJSON)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != want {
+				t.Errorf("expected %q, got %q", want, got)
+			}
+		})
+	}
+}
+
+func TestQueryIndexInputs(t *testing.T) {
+	lists := [][]int{{1, 2, 3}, {4, 5}, {6}}
+	tests := map[string]string{
+		"map(last)":           "[3,5,6]",
+		"map(first)":          "[1,4,6]",
+		".[] | .[-1]":         "3\n5\n6",
+		".[] | .[-2]":         "2\n4\nnull",
+		"map(.[1])":           "[2,5,null]",
+		"[.[] | .[-3]]":       "[1,null,null]",
+		".[] | [.[-1]]":       "[3]\n[5]\n[6]",
+		"map(length)":         "[3,2,1]",
+		"[.[] | last] | last": "6",
+	}
+	for query, want := range tests {
+		t.Run(query, func(t *testing.T) {
+			got, err := renderQuery(lists, query, FormatNDJSON)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != want {
+				t.Errorf("expected %q, got %q", want, got)
+			}
+		})
+	}
+}
+
+func TestCheckQueryInvalid(t *testing.T) {
+	tests := map[string]string{
+		".[] | select(":   "unexpected end",
+		`.name == "web`:   "unterminated string",
+		".name )":         `unexpected ")"`,
+		".[] | unknown()": "unknown",
+	}
+	for query, want := range tests {
+		t.Run(query, func(t *testing.T) {
+			err := CheckQuery(query)
+
+			if err == nil || !strings.Contains(err.Error(), want) {
+				t.Errorf("expected error containing %q, got %v", want, err)
+			}
+		})
+	}
+}
+
+func TestRenderQueryOption(t *testing.T) {
+	results := Results{{Name: "variable", Value: map[string]string{"Name": "KEY"}}, {Name: "status", Value: 201}}
+	tests := []struct {
+		data   any
+		format OutputFormat
+		query  string
+		want   string
+	}{
+		{data: testEvents, format: FormatTable, query: `.[] | select(.id == "event-2") | {id, name}`, want: "{\n  \"id\": \"event-2\",\n  \"name\": \"deploy, \\\"web\\\"\"\n}"},
+		{data: testEvents, format: FormatTable, query: "map(.id) | length", want: "2"},
+		{data: results, format: FormatTable, query: ".variable.Name", want: "KEY"},
+		{data: results, format: "jsonpath={.status}", want: "201"},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.format)+tt.query, func(t *testing.T) {
+			got, err := Render(tt.data, tt.format, Options{Query: tt.query})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
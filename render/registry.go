@@ -13,16 +13,74 @@ import (
 type OutputFormat string
 
 const (
-	FormatTable  OutputFormat = "table"
-	FormatDetail OutputFormat = "detail"
-	FormatJSON   OutputFormat = "json"
+	FormatTable    OutputFormat = "table"
+	FormatDetail   OutputFormat = "detail"
+	FormatJSON     OutputFormat = "json"
+	FormatNDJSON   OutputFormat = "ndjson" // One JSON document per line, one line per slice element
+	FormatYAML     OutputFormat = "yaml"
+	FormatCSV      OutputFormat = "csv" // A header of JSON keys, then a row per slice element
+	FormatTSV      OutputFormat = "tsv"
+	FormatMarkdown OutputFormat = "markdown"
+	FormatRaw      OutputFormat = "raw" // Values without styles or headers, for shell pipelines
+
+	// Formats taking a template after "=", e.g. "jsonpath={.name}"
+	FormatJSONPath   OutputFormat = "jsonpath"
+	FormatGoTemplate OutputFormat = "go-template"
 )
 
-// RenderResult renders any result based on its type using convention-based mapping
+// RenderResult renders any result based on its type using convention-based mapping,
+// detail views expanding Rules.MaxDepth levels.
 // Returns the rendered string and any error
 func RenderResult(data any, format OutputFormat) (string, error) {
-	if format == FormatJSON {
+	return Render(data, format, Options{Depth: Rules.MaxDepth})
+}
+
+// Render renders a result like RenderResult, tables showing the columns
+// selected by opts and lists being arranged by opts first. With opts.Query,
+// the values the query produces are rendered instead.
+func Render(data any, format OutputFormat, opts Options) (string, error) {
+	data, err := Arrange(data, opts)
+	if err != nil {
+		return "", err
+	}
+	if results, ok := data.(Results); ok {
+		return renderResults(results, format, opts)
+	}
+	if opts.Query != "" {
+		return renderQuery(data, opts.Query, format)
+	}
+
+	format, template := format.split()
+	switch format {
+	case FormatJSONPath:
+		return renderJSONPath(data, template)
+	case FormatGoTemplate:
+		return renderGoTemplate(data, template)
+	case FormatJSON:
 		return renderJSON(data)
+	case FormatNDJSON:
+		v := reflect.ValueOf(data)
+		if v.Kind() != reflect.Slice {
+			v = reflect.ValueOf([]any{data})
+		}
+		return strings.TrimSuffix(renderNDJSON(v), "\n"), nil
+	case FormatYAML:
+		return renderYAML(data)
+	case FormatCSV, FormatTSV, FormatMarkdown, FormatRaw:
+		if format == FormatRaw || !hasRecords(reflect.ValueOf(data)) {
+			return renderRaw(data, opts)
+		}
+		if format == FormatMarkdown {
+			return renderMarkdown(data, opts)
+		}
+		comma := ','
+		if format == FormatTSV {
+			comma = '\t'
+		}
+		return renderDelimited(data, opts, comma)
+	case FormatTable, FormatDetail:
+	default:
+		return "", unknownFormatError(string(format))
 	}
 
 	// Check for nil
@@ -61,7 +119,7 @@ func RenderResult(data any, format OutputFormat) (string, error) {
 	switch v.Kind() {
 	case reflect.Slice:
 		// []Type or []*Type -> Table
-		renderer, err := NewTableRenderer(data)
+		renderer, err := NewTableRenderer(data, opts)
 		if err != nil {
 			return "", err
 		}
@@ -70,7 +128,7 @@ func RenderResult(data any, format OutputFormat) (string, error) {
 	case reflect.Struct:
 		// *Type -> Detail view
 		typeName := v.Type().Name()
-		renderer, err := NewDetailRenderer(data, typeName)
+		renderer, err := NewDetailRenderer(data, typeName, opts)
 		if err != nil {
 			return "", err
 		}
@@ -0,0 +1,104 @@
+package render
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// NamedResult is a return value of an SDK method, named in the manifest
+type NamedResult struct {
+	Name  string
+	Value any
+}
+
+// Results are the return values of a method rendered together, in order
+type Results []NamedResult
+
+// renderResults renders several return values, already arranged by Render.
+// JSON, YAML, templates and queries see them as an object keyed by result
+// name, other formats render each one in turn, scalar values as key/value
+// lines.
+func renderResults(results Results, format OutputFormat, opts Options) (string, error) {
+	base, _ := format.split()
+	if opts.Query != "" || base == FormatJSON || base == FormatNDJSON || base == FormatYAML || base == FormatJSONPath || base == FormatGoTemplate {
+		envelope, err := resultsEnvelope(results)
+		if err != nil {
+			return "", err
+		}
+		switch {
+		case opts.Query != "" || base == FormatYAML || base == FormatJSONPath || base == FormatGoTemplate:
+			return Render(json.RawMessage(envelope), format, opts)
+		case format == FormatNDJSON:
+			return string(envelope), nil
+		}
+		var out bytes.Buffer
+		if err := json.Indent(&out, envelope, "", "  "); err != nil {
+			return "", fmt.Errorf("failed to marshal to JSON: %w", err)
+		}
+		return out.String(), nil
+	}
+
+	if len(results) == 0 {
+		return RenderSuccess("Operation completed successfully"), nil
+	}
+
+	var parts, scalars []string
+	for _, r := range results {
+		if isScalar(r.Value) && (format == FormatTable || format == FormatDetail) {
+			scalars = append(scalars, KeyStyle.Render(r.Name)+ValueStyle.Render(fmt.Sprintf("%v", r.Value)))
+			continue
+		}
+		if isScalar(r.Value) {
+			scalars = append(scalars, fmt.Sprintf("%s: %v", r.Name, r.Value))
+			continue
+		}
+		out, err := Render(r.Value, format, opts)
+		if err != nil {
+			return "", err
+		}
+		parts = append(parts, out)
+	}
+	if len(scalars) > 0 {
+		parts = append(parts, strings.Join(scalars, "\n"))
+	}
+	return strings.Join(parts, "\n"), nil
+}
+
+// resultsEnvelope marshals results as a JSON object, keeping their order
+func resultsEnvelope(results Results) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, r := range results {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(r.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal to JSON: %w", err)
+		}
+		value, err := json.Marshal(r.Value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal to JSON: %w", err)
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// isScalar reports whether a value is rendered inline rather than as a table or detail view
+func isScalar(value any) bool {
+	if value == nil {
+		return true
+	}
+	switch reflect.Indirect(reflect.ValueOf(value)).Kind() {
+	case reflect.Struct, reflect.Slice, reflect.Map, reflect.Array:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,187 @@
+package render
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/websocket"
+)
+
+// ErrStreamDropped is returned when a stream ends before the server ended it,
+// e.g. on a network error: the command may connect again
+var ErrStreamDropped = errors.New("stream dropped")
+
+// StreamStatusError is returned when a stream ends with a failure status event,
+// e.g. a deployment ending with build-error
+type StreamStatusError struct {
+	Status string
+}
+
+func (e *StreamStatusError) Error() string {
+	return fmt.Sprintf("stream ended with status %s", e.Status)
+}
+
+// StreamResult renders a long-lived result as it arrives: the body of an
+// *http.Response, or the messages of a *websocket.Conn. Streams are never
+// paged, see Unpaged. The summary of a cancelled stream is written to stderr.
+func StreamResult(ctx context.Context, w, stderr io.Writer, result any, format OutputFormat) error {
+	switch stream := result.(type) {
+	case *http.Response:
+		return StreamResponse(ctx, w, stderr, stream)
+	case *websocket.Conn:
+		return StreamEvents(ctx, w, stderr, stream, format)
+	default:
+		return fmt.Errorf("cannot stream a %T result", result)
+	}
+}
+
+// StreamResponse copies an HTTP response body to w as it arrives instead of
+// buffering it. If the context is cancelled mid-stream (Ctrl-C or --timeout),
+// a summary of what was received is written to stderr and the context error
+// is returned.
+func StreamResponse(ctx context.Context, w, stderr io.Writer, resp *http.Response) error {
+	if resp == nil {
+		return nil
+	}
+	w = Unpaged(w)
+	if resp.Body == nil {
+		fmt.Fprintln(w, RenderHTTPStatus(resp.StatusCode))
+		return nil
+	}
+	defer resp.Body.Close()
+
+	// Close the body when the context is done so a blocked read returns
+	stop := context.AfterFunc(ctx, func() { resp.Body.Close() })
+	defer stop()
+
+	counter := &streamCounter{w: w}
+	_, err := io.Copy(counter, resp.Body)
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		fmt.Fprintln(stderr, RenderStreamSummary(counter.lines, counter.bytes, ctxErr))
+		return ctxErr
+	}
+	if err != nil {
+		return fmt.Errorf("%w: failed to read response body: %w", ErrStreamDropped, err)
+	}
+	if counter.bytes == 0 {
+		fmt.Fprintln(w, RenderHTTPStatus(resp.StatusCode))
+	}
+	return nil
+}
+
+// StreamEvents renders the messages of a websocket as they arrive, one line
+// each, until the server closes it or a final status event is received. JSON
+// formats print the messages as is, tables print log contents and statuses.
+// Like StreamResponse, a cancelled stream writes a summary to stderr.
+func StreamEvents(ctx context.Context, w, stderr io.Writer, conn *websocket.Conn, format OutputFormat) error {
+	if conn == nil {
+		return nil
+	}
+	defer conn.Close()
+	w = Unpaged(w)
+
+	// Close the connection when the context is done so a blocked read returns
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+
+	counter := &streamCounter{w: w}
+	for {
+		_, msg, err := conn.ReadMessage()
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			fmt.Fprintln(stderr, RenderStreamSummary(counter.lines, counter.bytes, ctxErr))
+			return ctxErr
+		}
+		if websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrStreamDropped, err)
+		}
+
+		event := parseStreamEvent(msg)
+		if _, err := fmt.Fprintln(counter, renderStreamEvent(msg, event, format)); err != nil {
+			return err
+		}
+		switch {
+		case event.Type != "status":
+		case event.Data.Status == "success":
+			return nil
+		case event.Data.Status == "aborted" || strings.HasSuffix(event.Data.Status, "-error"):
+			return &StreamStatusError{Status: event.Data.Status}
+		}
+	}
+}
+
+// streamEvent is a JSON message of a websocket stream, e.g. the
+// {"type": "log", "data": {"content": "..."}} events of a deployment
+type streamEvent struct {
+	Type string `json:"type"`
+	Data struct {
+		Content string `json:"content"`
+		Status  string `json:"status"`
+	} `json:"data"`
+}
+
+// parseStreamEvent decodes a message, a zero event for other messages
+func parseStreamEvent(msg []byte) streamEvent {
+	var event streamEvent
+	if err := json.Unmarshal(msg, &event); err != nil {
+		return streamEvent{}
+	}
+	return event
+}
+
+// renderStreamEvent renders a websocket message as a line
+func renderStreamEvent(msg []byte, event streamEvent, format OutputFormat) string {
+	if format == FormatJSON || format == FormatNDJSON {
+		var compact bytes.Buffer
+		if err := json.Compact(&compact, msg); err != nil {
+			// Not JSON: print the text as a JSON string
+			b, _ := json.Marshal(string(msg))
+			return string(b)
+		}
+		return compact.String()
+	}
+
+	switch {
+	case event.Type == "log":
+		return strings.TrimRight(event.Data.Content, "\n")
+	case event.Type == "status":
+		return RenderInfo("Status: " + event.Data.Status)
+	case event.Type != "":
+		var compact bytes.Buffer
+		if err := json.Compact(&compact, msg); err == nil {
+			return SubtitleStyle.Render(event.Type) + " " + compact.String()
+		}
+	}
+	return strings.TrimRight(string(msg), "\n")
+}
+
+// RenderStreamSummary renders what a stream received before it was stopped
+func RenderStreamSummary(lines, size int64, cause error) string {
+	reason := "interrupted"
+	if errors.Is(cause, context.DeadlineExceeded) {
+		reason = "timed out"
+	}
+	return RenderWarning(fmt.Sprintf("Stream %s after receiving %d lines (%d bytes)", reason, lines, size))
+}
+
+// streamCounter counts the bytes and lines written through it
+type streamCounter struct {
+	w     io.Writer
+	bytes int64
+	lines int64
+}
+
+func (c *streamCounter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.bytes += int64(n)
+	c.lines += int64(bytes.Count(p[:n], []byte("\n")))
+	return n, err
+}
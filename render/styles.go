@@ -2,65 +2,117 @@ package render
 
 import "github.com/charmbracelet/lipgloss"
 
+// Colors and styles of the active theme, set by UseTheme
 var (
 	// Colors
-	primaryColor   = lipgloss.Color("#7C3AED") // Purple
-	secondaryColor = lipgloss.Color("#10B981") // Green
-	errorColor     = lipgloss.Color("#EF4444") // Red
-	mutedColor     = lipgloss.Color("#6B7280") // Gray
-	borderColor    = lipgloss.Color("#374151") // Dark gray
+	primaryColor   lipgloss.TerminalColor
+	secondaryColor lipgloss.TerminalColor
+	errorColor     lipgloss.TerminalColor
+	mutedColor     lipgloss.TerminalColor
+	borderColor    lipgloss.TerminalColor
+	rowColor       lipgloss.TerminalColor
+	altRowColor    lipgloss.TerminalColor
 
 	// Base styles
+	TitleStyle    lipgloss.Style
+	SubtitleStyle lipgloss.Style
+
+	// Table styles
+	TableHeaderStyle   lipgloss.Style
+	TableCellStyle     lipgloss.Style
+	TableSelectedStyle lipgloss.Style
+
+	// Detail view styles
+	KeyStyle   lipgloss.Style
+	ValueStyle lipgloss.Style
+
+	// Status styles
+	SuccessStyle lipgloss.Style
+	ErrorStyle   lipgloss.Style
+	WarningStyle lipgloss.Style
+
+	// Box style for detail views
+	BoxStyle lipgloss.Style
+
+	// Error box styles
+	errorBoxStyle   lipgloss.Style
+	errorTitleStyle lipgloss.Style
+	errorMsgStyle   lipgloss.Style
+)
+
+func init() {
+	applyTheme(Themes[ThemeDark])
+}
+
+// applyTheme sets the colors and styles to those of a theme
+func applyTheme(t Theme) {
+	primaryColor = themeColor(t.Primary)
+	secondaryColor = themeColor(t.Secondary)
+	errorColor = themeColor(t.Error)
+	mutedColor = themeColor(t.Muted)
+	borderColor = themeColor(t.Border)
+	rowColor = themeColor(t.Row)
+	altRowColor = themeColor(t.AltRow)
+
 	TitleStyle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(primaryColor).
-			MarginBottom(1)
+		Bold(true).
+		Foreground(primaryColor).
+		MarginBottom(1)
 
 	SubtitleStyle = lipgloss.NewStyle().
-			Foreground(mutedColor).
-			Italic(true)
+		Foreground(mutedColor).
+		Italic(true)
 
-	// Table styles
 	TableHeaderStyle = lipgloss.NewStyle().
-				Bold(true).
-				Foreground(primaryColor).
-				BorderStyle(lipgloss.NormalBorder()).
-				BorderBottom(true).
-				BorderForeground(borderColor).
-				Padding(0, 1)
+		Bold(true).
+		Foreground(primaryColor).
+		BorderStyle(lipgloss.NormalBorder()).
+		BorderBottom(true).
+		BorderForeground(borderColor).
+		Padding(0, 1)
 
 	TableCellStyle = lipgloss.NewStyle().
-			Padding(0, 1)
+		Padding(0, 1)
 
 	TableSelectedStyle = lipgloss.NewStyle().
-				Background(lipgloss.Color("#1F2937")).
-				Padding(0, 1)
+		Background(themeColor(t.Selected)).
+		Padding(0, 1)
 
-	// Detail view styles
 	KeyStyle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(secondaryColor).
-			Width(20)
+		Bold(true).
+		Foreground(secondaryColor).
+		Width(20)
 
 	ValueStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#F3F4F6"))
+		Foreground(themeColor(t.Value))
 
-	// Status styles
 	SuccessStyle = lipgloss.NewStyle().
-			Foreground(secondaryColor).
-			Bold(true)
+		Foreground(secondaryColor).
+		Bold(true)
 
 	ErrorStyle = lipgloss.NewStyle().
-			Foreground(errorColor).
-			Bold(true)
+		Foreground(errorColor).
+		Bold(true)
 
 	WarningStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#F59E0B")).
-			Bold(true)
+		Foreground(themeColor(t.Warning)).
+		Bold(true)
 
-	// Box style for detail views
 	BoxStyle = lipgloss.NewStyle().
-			Border(lipgloss.RoundedBorder()).
-			BorderForeground(borderColor).
-			Padding(1, 2)
-)
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(borderColor).
+		Padding(1, 2)
+
+	errorBoxStyle = lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(errorColor).
+		Padding(0, 1).
+		MarginTop(1)
+
+	errorTitleStyle = lipgloss.NewStyle().
+		Bold(true).
+		Foreground(errorColor)
+
+	errorMsgStyle = lipgloss.NewStyle().
+		Foreground(themeColor(t.ErrorText))
+}
@@ -2,6 +2,7 @@ package render
 
 import (
 	"fmt"
+	"math"
 	"os"
 	"reflect"
 
@@ -16,8 +17,9 @@ type TableRenderer struct {
 	rows    [][]string
 }
 
-// NewTableRenderer creates a table renderer from a slice of structs
-func NewTableRenderer(data any) (*TableRenderer, error) {
+// NewTableRenderer creates a table renderer from a slice of structs, showing
+// the columns selected by opts
+func NewTableRenderer(data any, opts Options) (*TableRenderer, error) {
 	v := reflect.ValueOf(data)
 	if v.Kind() == reflect.Ptr {
 		v = v.Elem()
@@ -27,132 +29,92 @@ func NewTableRenderer(data any) (*TableRenderer, error) {
 		return nil, fmt.Errorf("expected slice, got %s", v.Kind())
 	}
 
-	if v.Len() == 0 {
-		return &TableRenderer{}, nil
+	recs, err := extractRecords(v, opts, tableCells)
+	if err != nil {
+		return nil, err
 	}
-
-	// Get columns from first element's struct fields
-	first := v.Index(0)
-	if first.Kind() == reflect.Ptr {
-		first = first.Elem()
-	}
-
-	if first.Kind() != reflect.Struct {
-		return nil, fmt.Errorf("expected struct elements, got %s", first.Kind())
-	}
-
-	t := first.Type()
-	var columns []string
-	var fieldIndices []int
-
-	for i := 0; i < t.NumField(); i++ {
-		field := t.Field(i)
-		// Skip unexported fields and complex types
-		if !field.IsExported() {
-			continue
-		}
-		kind := field.Type.Kind()
-		if kind == reflect.Struct || kind == reflect.Slice || kind == reflect.Map || kind == reflect.Ptr {
-			// Skip complex types for table display
-			continue
-		}
-		columns = append(columns, field.Name)
-		fieldIndices = append(fieldIndices, i)
-	}
-
-	// Build rows
-	var rows [][]string
-	for i := 0; i < v.Len(); i++ {
-		elem := v.Index(i)
-		if elem.Kind() == reflect.Ptr {
-			elem = elem.Elem()
-		}
-
-		var row []string
-		for _, idx := range fieldIndices {
-			val := elem.Field(idx)
-			row = append(row, formatValue(val))
-		}
-		rows = append(rows, row)
-	}
-
 	return &TableRenderer{
-		columns: columns,
-		rows:    rows,
+		columns: recs.headers(),
+		rows:    recs.rows,
 	}, nil
 }
 
 // getTerminalWidth returns the terminal width or a default value
 func getTerminalWidth() int {
-	width, _, err := term.GetSize(int(os.Stdout.Fd()))
-	if err != nil || width <= 0 {
-		return 120 // default fallback
-	}
+	width, _ := TerminalSize()
 	return width
 }
 
-// Render renders the table using lipgloss/table with terminal-adaptive width
+// TerminalSize returns the size of the terminal of stdout, 120x40 when it
+// isn't one
+func TerminalSize() (width, height int) {
+	width, height, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil || width <= 0 || height <= 0 {
+		return 120, 40 // default fallback
+	}
+	return width, height
+}
+
+// tableWidth returns the width wide tables are shrunk to, none with ScrollWide
+func tableWidth() int {
+	if ScrollWide {
+		return math.MaxInt
+	}
+	return getTerminalWidth()
+}
+
+// Render renders the table using lipgloss/table with terminal-adaptive width.
+// Wide tables keep their width with ScrollWide.
 func (tr *TableRenderer) Render() string {
 	if len(tr.columns) == 0 {
 		return SubtitleStyle.Render("No data to display")
 	}
 
-	termWidth := getTerminalWidth()
+	if Plain {
+		return trimLines(newTable(tr.columns, tr.rows).Render())
+	}
+	// lipgloss/table distributes the terminal width between the columns,
+	// truncating the cells of wide tables
+	width := getTerminalWidth()
+	if wide := newTable(tr.columns, tr.rows).Render(); ScrollWide && lipgloss.Width(wide) > width {
+		return wide
+	}
+	return newTable(tr.columns, tr.rows).Width(width).Wrap(false).Render()
+}
 
-	// Create table with lipgloss/table - it handles width distribution automatically
-	t := table.New().
+// newTable creates a lipgloss table of rows with the table styles, as wide as
+// its content. Plain tables have no borders, their columns being separated by
+// two spaces.
+func newTable(columns []string, rows [][]string) *table.Table {
+	t := table.New()
+	cell := lipgloss.NewStyle().Padding(0, 1)
+	if Plain {
+		t = t.BorderTop(false).BorderBottom(false).BorderLeft(false).BorderRight(false).
+			BorderColumn(false).BorderHeader(false)
+		cell = lipgloss.NewStyle().PaddingRight(2)
+	}
+	return t.
 		Border(lipgloss.RoundedBorder()).
 		BorderStyle(lipgloss.NewStyle().Foreground(borderColor)).
-		Width(termWidth).
 		StyleFunc(func(row, col int) lipgloss.Style {
 			if row == table.HeaderRow {
-				return lipgloss.NewStyle().
+				return cell.
 					Bold(true).
-					Foreground(primaryColor).
-					Padding(0, 1)
+					Foreground(primaryColor)
 			}
 			// Alternate row colors for better readability
 			if row%2 == 0 {
-				return lipgloss.NewStyle().
-					Foreground(lipgloss.Color("#9CA3AF")).
-					Padding(0, 1)
+				return cell.
+					Foreground(altRowColor)
 			}
-			return lipgloss.NewStyle().
-				Foreground(lipgloss.Color("#D1D5DB")).
-				Padding(0, 1)
+			return cell.
+				Foreground(rowColor)
 		}).
-		Headers(tr.columns...).
-		Rows(tr.rows...)
-
-	return t.Render()
+		Headers(columns...).
+		Rows(rows...)
 }
 
 // RenderSimple renders a simple table (kept for compatibility)
 func (tr *TableRenderer) RenderSimple() string {
 	return tr.Render()
 }
-
-func formatValue(v reflect.Value) string {
-	switch v.Kind() {
-	case reflect.String:
-		return v.String()
-	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		return fmt.Sprintf("%d", v.Int())
-	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-		return fmt.Sprintf("%d", v.Uint())
-	case reflect.Float32, reflect.Float64:
-		return fmt.Sprintf("%.2f", v.Float())
-	case reflect.Bool:
-		if v.Bool() {
-			return "true"
-		}
-		return "false"
-	case reflect.Ptr:
-		if v.IsNil() {
-			return "-"
-		}
-		return formatValue(v.Elem())
-	default:
-		return fmt.Sprintf("%v", v.Interface())
-	}
-}
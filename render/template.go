@@ -0,0 +1,193 @@
+package render
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// TemplateFuncs are the helpers available to -o go-template and
+// --template-file templates, in addition to the text/template built-ins
+var TemplateFuncs = template.FuncMap{
+	"ago":      templateAgo,
+	"date":     templateDate,
+	"duration": templateDuration,
+	"bytes":    templateBytes,
+	"json":     templateJSON,
+	"upper":    strings.ToUpper,
+	"lower":    strings.ToLower,
+	"join":     templateJoin,
+}
+
+// parseGoTemplate parses a go-template with TemplateFuncs
+func parseGoTemplate(text string) (*template.Template, error) {
+	tmpl, err := template.New("output").Funcs(TemplateFuncs).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid go-template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// CheckGoTemplate reports syntax errors in a go-template
+func CheckGoTemplate(text string) error {
+	_, err := parseGoTemplate(text)
+	return err
+}
+
+// renderGoTemplate executes a go-template on the JSON form of data, so fields
+// are accessed by JSON key (e.g., {{.owner.username}})
+func renderGoTemplate(data any, text string) (string, error) {
+	tmpl, err := parseGoTemplate(text)
+	if err != nil {
+		return "", err
+	}
+	value, err := jsonValue(data)
+	if err != nil {
+		return "", err
+	}
+	var sb strings.Builder
+	if err := tmpl.Execute(&sb, value); err != nil {
+		return "", fmt.Errorf("go-template: %w", err)
+	}
+	return sb.String(), nil
+}
+
+// templateTime converts an RFC 3339 string or a time to a time
+func templateTime(v any) (time.Time, error) {
+	switch t := v.(type) {
+	case time.Time:
+		return t, nil
+	case *time.Time:
+		if t != nil {
+			return *t, nil
+		}
+	case string:
+		parsed, err := time.Parse(time.RFC3339, t)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid time %q", t)
+		}
+		return parsed, nil
+	case nil:
+	default:
+		return time.Time{}, fmt.Errorf("invalid time %v", v)
+	}
+	return time.Time{}, nil
+}
+
+// templateAgo formats a time relative to now (e.g., "3 hours ago")
+func templateAgo(v any) (string, error) {
+	t, err := templateTime(v)
+	if err != nil || t.IsZero() {
+		return "", err
+	}
+	return relativeTime(time.Since(t)), nil
+}
+
+// relativeTime describes an elapsed duration in its largest unit
+func relativeTime(d time.Duration) string {
+	suffix := "ago"
+	if d < 0 {
+		d, suffix = -d, "from now"
+	}
+	units := []struct {
+		name string
+		size time.Duration
+	}{
+		{"year", 365 * 24 * time.Hour},
+		{"month", 30 * 24 * time.Hour},
+		{"day", 24 * time.Hour},
+		{"hour", time.Hour},
+		{"minute", time.Minute},
+		{"second", time.Second},
+	}
+	for _, unit := range units {
+		if n := int(d / unit.size); n >= 1 {
+			if n > 1 {
+				return fmt.Sprintf("%d %ss %s", n, unit.name, suffix)
+			}
+			return fmt.Sprintf("1 %s %s", unit.name, suffix)
+		}
+	}
+	return "just now"
+}
+
+// templateDate formats a time with a Go layout (e.g., {{date "2006-01-02" .created_at}})
+func templateDate(layout string, v any) (string, error) {
+	t, err := templateTime(v)
+	if err != nil || t.IsZero() {
+		return "", err
+	}
+	return t.Format(layout), nil
+}
+
+// templateDuration formats a number of seconds as a duration (e.g., "1h2m3s")
+func templateDuration(v any) (string, error) {
+	seconds, err := templateNumber(v)
+	if err != nil {
+		return "", err
+	}
+	return (time.Duration(seconds) * time.Second).String(), nil
+}
+
+// templateBytes formats a number of bytes with binary units (e.g., "1.5 KiB")
+func templateBytes(v any) (string, error) {
+	size, err := templateNumber(v)
+	if err != nil {
+		return "", err
+	}
+	units := []string{"B", "KiB", "MiB", "GiB", "TiB", "PiB"}
+	i := 0
+	for math.Abs(size) >= 1024 && i < len(units)-1 {
+		size /= 1024
+		i++
+	}
+	if i == 0 {
+		return fmt.Sprintf("%d B", int64(size)), nil
+	}
+	return fmt.Sprintf("%.1f %s", size, units[i]), nil
+}
+
+// templateNumber converts a JSON number or a Go number to a float64
+func templateNumber(v any) (float64, error) {
+	if n, ok := toNumber(v); ok {
+		return n, nil
+	}
+	switch n := v.(type) {
+	case int:
+		return float64(n), nil
+	case int64:
+		return float64(n), nil
+	case nil:
+		return 0, nil
+	}
+	return 0, fmt.Errorf("invalid number %v", v)
+}
+
+// templateJSON formats a value as compact JSON
+func templateJSON(v any) (string, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal to JSON: %w", err)
+	}
+	return string(b), nil
+}
+
+// templateJoin joins the items of an array with sep
+func templateJoin(sep string, v any) (string, error) {
+	items, ok := v.([]any)
+	if !ok && v != nil {
+		return "", fmt.Errorf("cannot join %s", jsonType(v))
+	}
+	parts := make([]string, len(items))
+	for i, item := range items {
+		s, err := queryValueString(item, false)
+		if err != nil {
+			return "", err
+		}
+		parts[i] = s
+	}
+	return strings.Join(parts, sep), nil
+}
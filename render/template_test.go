@@ -0,0 +1,21 @@
+package render
+
+import "testing"
+
+func TestFormatGoTemplate(t *testing.T) {
+	tests := map[OutputFormat]string{
+		`go-template={{range .}}{{.id}} {{date "Jan 2" .created_at}} {{upper .name}}{{"\n"}}{{end}}`: "event-1 Jan 1 DEPLOY, \"WEB\"\nevent-2 Jan 2 DEPLOY, \"WEB\"\n",
+		`go-template={{bytes 1536}} {{bytes 512}} {{duration 3723}} {{json (index . 0).id}}`:         "1.5 KiB 512 B 1h2m3s \"event-1\"",
+	}
+	for format, want := range tests {
+		t.Run(string(format), func(t *testing.T) {
+			got, err := Render(testEvents, format, Options{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != want {
+				t.Errorf("expected %q, got %q", want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+package render
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+// Names of the built-in themes
+const (
+	ThemeAuto         = "auto" // dark or light, following the terminal background
+	ThemeDark         = "dark"
+	ThemeLight        = "light"
+	ThemeHighContrast = "high-contrast"
+	ThemeMonochrome   = "monochrome"
+)
+
+// Theme holds the colors of every style. Colors are hex codes ("#7C3AED") or
+// ANSI color numbers ("13"); an empty color leaves the terminal default.
+type Theme struct {
+	Primary   string `toml:"primary"`    // Titles and table headers
+	Secondary string `toml:"secondary"`  // Detail keys and success messages
+	Error     string `toml:"error"`      // Error titles and boxes
+	ErrorText string `toml:"error_text"` // Error messages
+	Warning   string `toml:"warning"`
+	Muted     string `toml:"muted"` // Subtitles, footers and empty values
+	Border    string `toml:"border"`
+	Value     string `toml:"value"`    // Detail values
+	Row       string `toml:"row"`      // Odd table rows
+	AltRow    string `toml:"alt_row"`  // Even table rows, the first one included
+	Selected  string `toml:"selected"` // Background of selected table rows
+}
+
+// Themes are the themes UseTheme accepts, the built-in ones and those read by
+// LoadThemes
+var Themes = map[string]Theme{
+	ThemeDark: {
+		Primary:   "#7C3AED",
+		Secondary: "#10B981",
+		Error:     "#EF4444",
+		ErrorText: "#FCA5A5",
+		Warning:   "#F59E0B",
+		Muted:     "#6B7280",
+		Border:    "#374151",
+		Value:     "#F3F4F6",
+		Row:       "#D1D5DB",
+		AltRow:    "#9CA3AF",
+		Selected:  "#1F2937",
+	},
+	ThemeLight: {
+		Primary:   "#6D28D9",
+		Secondary: "#047857",
+		Error:     "#DC2626",
+		ErrorText: "#B91C1C",
+		Warning:   "#B45309",
+		Muted:     "#4B5563",
+		Border:    "#9CA3AF",
+		Value:     "#111827",
+		Row:       "#1F2937",
+		AltRow:    "#374151",
+		Selected:  "#E5E7EB",
+	},
+	// The 16 ANSI colors, which the terminal adapts to its background, and
+	// its default foreground for text
+	ThemeHighContrast: {
+		Primary:   "12",
+		Secondary: "10",
+		Error:     "9",
+		ErrorText: "9",
+		Warning:   "11",
+		Selected:  "8",
+	},
+	// Bold and italic text only
+	ThemeMonochrome: {},
+}
+
+// UseTheme makes the styles use the colors of a theme of Themes. ThemeAuto and
+// an empty name pick ThemeDark or ThemeLight by asking the terminal for its
+// background, unless colors are off or the output isn't a terminal: they then
+// pick ThemeDark without a query. UseTheme is called after ConfigureOutput.
+func UseTheme(name string) error {
+	if name == "" || name == ThemeAuto {
+		name = ThemeDark
+		if lipgloss.ColorProfile() != termenv.Ascii && !Plain && !lipgloss.HasDarkBackground() {
+			name = ThemeLight
+		}
+	}
+	theme, ok := Themes[name]
+	if !ok {
+		return fmt.Errorf("unknown theme %q, available themes: %s, %s", name, ThemeAuto, strings.Join(slices.Sorted(maps.Keys(Themes)), ", "))
+	}
+	applyTheme(theme)
+	return nil
+}
+
+// LoadThemes adds the themes of a TOML file to Themes, one table per theme:
+//
+//	[solarized]
+//	extends = "light"
+//	primary = "#268BD2"
+//
+// A theme extending another, built-in or defined above it, starts from its
+// colors.
+func LoadThemes(path string) error {
+	var themes map[string]toml.Primitive
+	md, err := toml.DecodeFile(path, &themes)
+	if err != nil {
+		return fmt.Errorf("failed to read themes: %w", err)
+	}
+
+	for _, key := range md.Keys() {
+		if len(key) != 1 {
+			continue
+		}
+		name := key[0]
+		var base struct {
+			Extends string `toml:"extends"`
+		}
+		if err := md.PrimitiveDecode(themes[name], &base); err != nil {
+			return fmt.Errorf("%s: theme %q: %w", path, name, err)
+		}
+
+		var theme Theme
+		if base.Extends != "" {
+			var ok bool
+			if theme, ok = Themes[base.Extends]; !ok {
+				return fmt.Errorf("%s: theme %q extends unknown theme %q", path, name, base.Extends)
+			}
+		}
+		if err := md.PrimitiveDecode(themes[name], &theme); err != nil {
+			return fmt.Errorf("%s: theme %q: %w", path, name, err)
+		}
+		if err := theme.check(); err != nil {
+			return fmt.Errorf("%s: theme %q: %w", path, name, err)
+		}
+		Themes[name] = theme
+	}
+
+	if undecoded := md.Undecoded(); len(undecoded) > 0 {
+		return fmt.Errorf("%s: unknown theme setting %q", path, undecoded[0].String())
+	}
+	return nil
+}
+
+var hexColor = regexp.MustCompile(`^#([0-9A-Fa-f]{3}|[0-9A-Fa-f]{6})$`)
+
+// check returns an error naming the first color which is neither a hex code
+// nor an ANSI color number
+func (t Theme) check() error {
+	colors := []struct{ name, value string }{
+		{"primary", t.Primary}, {"secondary", t.Secondary}, {"error", t.Error},
+		{"error_text", t.ErrorText}, {"warning", t.Warning}, {"muted", t.Muted},
+		{"border", t.Border}, {"value", t.Value}, {"row", t.Row},
+		{"alt_row", t.AltRow}, {"selected", t.Selected},
+	}
+	for _, c := range colors {
+		if c.value == "" || hexColor.MatchString(c.value) {
+			continue
+		}
+		if n, err := strconv.Atoi(c.value); err == nil && n >= 0 && n <= 255 {
+			continue
+		}
+		return fmt.Errorf("invalid %s color %q, expected #RGB, #RRGGBB or an ANSI color number", c.name, c.value)
+	}
+	return nil
+}
+
+// themeColor converts a theme color, empty colors leaving the terminal default
+func themeColor(c string) lipgloss.TerminalColor {
+	if c == "" {
+		return lipgloss.NoColor{}
+	}
+	return lipgloss.Color(c)
+}
@@ -0,0 +1,149 @@
+package render
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+func TestUseThemeAutoWithoutColors(t *testing.T) {
+	prev := lipgloss.ColorProfile()
+	t.Cleanup(func() {
+		lipgloss.SetColorProfile(prev)
+		UseTheme(ThemeDark)
+	})
+	lipgloss.SetColorProfile(termenv.Ascii)
+	UseTheme(ThemeLight)
+
+	// Without colors, the terminal isn't asked for its background
+	if err := UseTheme(ThemeAuto); err != nil {
+		t.Fatal(err)
+	}
+
+	if primaryColor != themeColor(Themes[ThemeDark].Primary) {
+		t.Errorf("expected the dark theme, got primary color %v", primaryColor)
+	}
+}
+
+// useTheme applies a theme with true colors, restoring the dark theme after
+// the test
+func useTheme(t *testing.T, name string) {
+	t.Helper()
+	prev := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	if err := UseTheme(name); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		lipgloss.SetColorProfile(prev)
+		UseTheme(ThemeDark)
+	})
+}
+
+// writeThemes writes a themes file and removes the themes it defines after the test
+func writeThemes(t *testing.T, content string, names ...string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "themes.toml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		for _, name := range names {
+			delete(Themes, name)
+		}
+	})
+	return path
+}
+
+func TestThemeStyles(t *testing.T) {
+	tests := map[string]struct {
+		want, notWant string
+	}{
+		ThemeDark:         {want: "\x1b[1;38;2;124;58;237m", notWant: "109;40;217"},
+		ThemeLight:        {want: "\x1b[1;38;2;109;40;217m", notWant: "124;58;237"},
+		ThemeHighContrast: {want: "\x1b[1;94m", notWant: "38;2;"},
+		ThemeMonochrome:   {want: "\x1b[1m", notWant: "38;"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			useTheme(t, name)
+
+			out, err := Render([]testApp{{Name: "web"}}, FormatTable, Options{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !strings.Contains(out, tt.want) {
+				t.Errorf("expected header styled with %q, got %q", tt.want, out)
+			}
+			if strings.Contains(out, tt.notWant) {
+				t.Errorf("expected no %q, got %q", tt.notWant, out)
+			}
+		})
+	}
+}
+
+func TestUseThemeUnknown(t *testing.T) {
+	err := UseTheme("solarized")
+
+	want := `unknown theme "solarized", available themes: auto, dark, high-contrast, light, monochrome`
+	if err == nil || err.Error() != want {
+		t.Errorf("expected error %q, got %v", want, err)
+	}
+}
+
+func TestLoadThemes(t *testing.T) {
+	path := writeThemes(t, `
+[paper]
+extends = "light"
+primary = "#268BD2"
+
+[paper-mono]
+extends = "paper"
+secondary = ""
+row = "240"
+
+[short]
+primary = "#26B"
+`, "paper", "paper-mono", "short")
+
+	if err := LoadThemes(path); err != nil {
+		t.Fatal(err)
+	}
+
+	paper := Themes["paper"]
+	if paper.Primary != "#268BD2" || paper.Secondary != Themes[ThemeLight].Secondary {
+		t.Errorf("expected light with a blue primary color, got %+v", paper)
+	}
+	mono := Themes["paper-mono"]
+	if mono.Primary != "#268BD2" || mono.Secondary != "" || mono.Row != "240" {
+		t.Errorf("expected paper without secondary color, got %+v", mono)
+	}
+	useTheme(t, "paper")
+	useTheme(t, "short")
+}
+
+func TestLoadThemesInvalid(t *testing.T) {
+	tests := map[string]string{
+		"[a]\nprimary = \"purple\"\n":   `theme "a": invalid primary color "purple", expected #RGB, #RRGGBB or an ANSI color number`,
+		"[a]\nprimary = \"256\"\n":      `theme "a": invalid primary color "256"`,
+		"[a]\nextends = \"sepia\"\n":    `theme "a" extends unknown theme "sepia"`,
+		"[a]\nprimry = \"#FFFFFF\"\n":   `unknown theme setting "a.primry"`,
+		"[a]\nprimary = \"#FFFFFF\"\n[": "failed to read themes",
+	}
+	for content, want := range tests {
+		t.Run(want, func(t *testing.T) {
+			path := writeThemes(t, content, "a")
+
+			err := LoadThemes(path)
+
+			if err == nil || !strings.Contains(err.Error(), want) {
+				t.Errorf("expected error containing %q, got %v", want, err)
+			}
+		})
+	}
+}
@@ -1,32 +1,207 @@
 package runtimecli
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
 
 	"github.com/spf13/cobra"
 
+	"generative-cli/config"
 	"generative-cli/generated/commands"
+	"generative-cli/render"
 )
 
-// NewRootCommand builds the root command for the generated CLI.
-func NewRootCommand() *cobra.Command {
+// Exit codes used when a command doesn't run to completion
+const (
+	ExitError       = 1
+	ExitTimeout     = 124 // same as timeout(1)
+	ExitInterrupted = 130 // 128 + SIGINT, 128 + the signal number for others
+)
+
+// NewRootCommand builds the root command for the generated CLI. The returned
+// func releases what running the command set up, the pager and the --timeout
+// context, and must be called once it returned.
+func NewRootCommand() (*cobra.Command, func()) {
+	var timeout time.Duration
+	cancel := context.CancelFunc(func() {})
+	var pager *render.Pager
+	var color string
+	var noColor, noPager bool
+
 	rootCmd := &cobra.Command{
 		Use:          "scalingo-gen",
 		Short:        "Generated Scalingo CLI",
 		Long:         "A generated CLI built from go-scalingo methods using the manifest as source of truth.",
 		SilenceUsage: true,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			// --config may point to another configuration file, reload now that flags are parsed
+			config.C.Load()
+
+			if noColor {
+				color = render.ColorNever
+			}
+			if err := render.ConfigureOutput(os.Stdout, color, os.Environ()); err != nil {
+				return err
+			}
+			if err := useTheme(); err != nil {
+				return err
+			}
+			if !noPager && !render.Plain {
+				pager = usePager(cmd.Root())
+			}
+
+			if timeout > 0 {
+				var ctx context.Context
+				ctx, cancel = context.WithTimeout(cmd.Context(), timeout)
+				cmd.SetContext(ctx)
+			}
+			return nil
+		},
 	}
 
+	flags := rootCmd.PersistentFlags()
+	flags.StringVarP(&config.C.AppFlag, "app", "a", "", "App name (defaults to $SCALINGO_APP or the "+config.ContextFileName+" context file)")
+	flags.StringVar(&config.C.RegionFlag, "region", "", "Region (defaults to $SCALINGO_REGION, the "+config.ContextFileName+" context file or the configuration file)")
+	flags.StringVarP(&config.C.OutputFlag, "output", "o", "table", "Output format ("+outputFormats()+")")
+	flags.StringSliceVar(&config.C.ColumnsFlag, "columns", nil, "Table columns to show, dotted for nested fields (e.g., name,status,owner.username)")
+	flags.BoolVar(&config.C.WideFlag, "wide", false, "Show every table column instead of the default ones")
+	flags.IntVar(&config.C.DepthFlag, "depth", render.Rules.MaxDepth, "Levels of nested fields, lists and maps the detail view expands")
+	flags.StringVar(&config.C.QueryFlag, "query", "", "Expression applied to the JSON form of the result (e.g., '.[] | select(.status == \"running\") | .name')")
+	flags.StringVar(&config.C.TemplateFileFlag, "template-file", "", "Render the result with the go-template in this file")
+	flags.StringVar(&color, "color", render.ColorAuto, "When to use colors: auto (following NO_COLOR and CLICOLOR_FORCE), always or never")
+	flags.BoolVar(&noColor, "no-color", false, "Disable colors, same as --color=never")
+	flags.BoolVar(&noPager, "no-pager", false, "Never page the output through $PAGER (or "+render.DefaultPager+"), truncating wide tables instead")
+	flags.StringVar(&config.C.APITokenFlag, "api-token", "", "API token (defaults to $SCALINGO_API_TOKEN or the auth file)")
+	flags.StringVar(&config.C.ConfigFile, "config", config.C.ConfigFile, "Path to the configuration file")
+	flags.DurationVar(&timeout, "timeout", 0, "Abort the command after this duration (e.g., 30s, 2m)")
+
+	rootCmd.MarkFlagsMutuallyExclusive("columns", "wide")
+	rootCmd.MarkFlagsMutuallyExclusive("output", "template-file")
+	rootCmd.MarkFlagsMutuallyExclusive("color", "no-color")
+
 	commands.RegisterAll(rootCmd)
 
-	return rootCmd
+	release := func() {
+		cancel()
+		if pager == nil {
+			return
+		}
+		if err := pager.Close(); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+		}
+	}
+	return rootCmd, release
+}
+
+// useTheme reads the user themes, if their file exists, and applies the
+// configured theme
+func useTheme() error {
+	if _, err := os.Stat(config.C.ThemesFile); err == nil {
+		if err := render.LoadThemes(config.C.ThemesFile); err != nil {
+			return err
+		}
+	}
+	return render.UseTheme(config.C.Theme)
+}
+
+// usePager pages the output of root through $PAGER when it doesn't fit in
+// the terminal, wide tables then being left for the pager to scroll. The
+// pager, nil when paging is disabled, must be closed once the command ran.
+func usePager(root *cobra.Command) *render.Pager {
+	width, height := render.TerminalSize()
+	pager := render.NewPager(os.Stdout, os.Environ(), width, height)
+	if pager == nil {
+		return nil
+	}
+	render.ScrollWide = true
+	root.SetOut(pager)
+	return pager
+}
+
+// outputFormats lists the formats --output accepts
+func outputFormats() string {
+	var formats []string
+	for _, format := range render.Formats {
+		formats = append(formats, string(format))
+	}
+	for _, format := range render.TemplateFormats {
+		formats = append(formats, string(format)+"=TEMPLATE")
+	}
+	return strings.Join(formats, ", ")
 }
 
-// Execute runs the generated CLI.
+// Execute runs the generated CLI. The command context is cancelled on SIGINT
+// or SIGTERM so in-flight SDK calls are aborted cleanly.
 func Execute() {
-	if err := NewRootCommand().Execute(); err != nil {
+	ctx, stop := notifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+
+	rootCmd, release := NewRootCommand()
+	cmd, err := rootCmd.ExecuteContextC(ctx)
+	code := exitCode(ctx, cmd, err)
+	release()
+	stop()
+
+	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
-		os.Exit(1)
+		os.Exit(code)
+	}
+}
+
+// signalError is the cause of a context cancelled by a signal
+type signalError struct {
+	signal os.Signal
+}
+
+func (e signalError) Error() string {
+	return "received " + e.signal.String()
+}
+
+// notifyContext is like signal.NotifyContext, the context being cancelled
+// with a signalError naming the signal. Only the first signal is caught: the
+// next ones kill the process, for a command that doesn't stop.
+func notifyContext(parent context.Context, signals ...os.Signal) (context.Context, func()) {
+	ctx, cancel := context.WithCancelCause(parent)
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, signals...)
+	go func() {
+		select {
+		case sig := <-ch:
+			signal.Stop(ch)
+			cancel(signalError{sig})
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, func() {
+		signal.Stop(ch)
+		cancel(nil)
+	}
+}
+
+// exitCode picks the process status for a command result, distinguishing
+// interruptions and timeouts from regular failures. A command stopped by a
+// signal exits with 128 + the signal number, like a shell reports it.
+func exitCode(ctx context.Context, cmd *cobra.Command, err error) int {
+	var sigErr signalError
+	switch {
+	case err == nil:
+		return 0
+	case ctx.Err() != nil:
+		if errors.As(context.Cause(ctx), &sigErr) {
+			if sig, ok := sigErr.signal.(syscall.Signal); ok {
+				return 128 + int(sig)
+			}
+		}
+		return ExitInterrupted
+	case errors.Is(err, context.DeadlineExceeded),
+		cmd != nil && cmd.Context() != nil && errors.Is(cmd.Context().Err(), context.DeadlineExceeded):
+		return ExitTimeout
+	default:
+		return ExitError
 	}
 }
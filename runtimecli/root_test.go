@@ -0,0 +1,99 @@
+package runtimecli
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"os"
+	"slices"
+	"syscall"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"generative-cli/generator"
+)
+
+func TestExitCode(t *testing.T) {
+	timedOut, cancelTimeout := context.WithTimeout(context.Background(), 0)
+	defer cancelTimeout()
+	<-timedOut.Done()
+	timeoutCmd := &cobra.Command{}
+	timeoutCmd.SetContext(timedOut)
+
+	interrupted := func(sig os.Signal) context.Context {
+		ctx, cancel := context.WithCancelCause(context.Background())
+		cancel(signalError{sig})
+		return ctx
+	}
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		cmd  *cobra.Command
+		err  error
+		want int
+	}{
+		{name: "success", ctx: context.Background(), want: 0},
+		{name: "error", ctx: context.Background(), err: errors.New("not found"), want: ExitError},
+		{name: "timeout error", ctx: context.Background(), err: context.DeadlineExceeded, want: ExitTimeout},
+		{name: "timeout context", ctx: context.Background(), cmd: timeoutCmd, err: errors.New("aborted"), want: ExitTimeout},
+		{name: "interrupt", ctx: interrupted(os.Interrupt), err: context.Canceled, want: ExitInterrupted},
+		{name: "terminate", ctx: interrupted(syscall.SIGTERM), err: context.Canceled, want: 143},
+		{name: "cancelled without signal", ctx: cancelled, err: context.Canceled, want: ExitInterrupted},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := exitCode(tt.ctx, tt.cmd, tt.err); got != tt.want {
+				t.Errorf("exitCode() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNotifyContext(t *testing.T) {
+	ctx, stop := notifyContext(context.Background(), syscall.SIGTERM)
+	defer stop()
+
+	process, err := os.FindProcess(os.Getpid())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := process.Signal(syscall.SIGTERM); err != nil {
+		t.Skipf("can't signal the test process: %v", err)
+	}
+	<-ctx.Done()
+
+	if got := exitCode(ctx, nil, ctx.Err()); got != 143 {
+		t.Errorf("exitCode() = %d after SIGTERM, want 143", got)
+	}
+}
+
+// TestReservedFlags checks that the generator reserves the root flags, so
+// generated commands don't declare them again
+func TestReservedFlags(t *testing.T) {
+	rootCmd, release := NewRootCommand()
+	defer release()
+	rootCmd.InitDefaultHelpFlag()
+
+	flags := make(map[string]bool)
+	shorthands := make(map[string]bool)
+	visit := func(flag *pflag.Flag) {
+		flags[flag.Name] = true
+		if flag.Shorthand != "" {
+			shorthands[flag.Shorthand] = true
+		}
+	}
+	rootCmd.PersistentFlags().VisitAll(visit)
+	rootCmd.Flags().VisitAll(visit)
+
+	if !maps.Equal(flags, generator.ReservedFlags) {
+		t.Errorf("root flags %v, generator.ReservedFlags %v", slices.Sorted(maps.Keys(flags)), slices.Sorted(maps.Keys(generator.ReservedFlags)))
+	}
+	if !maps.Equal(shorthands, generator.ReservedShorthands) {
+		t.Errorf("root shorthands %v, generator.ReservedShorthands %v", slices.Sorted(maps.Keys(shorthands)), slices.Sorted(maps.Keys(generator.ReservedShorthands)))
+	}
+}